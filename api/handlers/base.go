@@ -5,6 +5,7 @@ package handlers
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/utils"
 )
 
 func GetUserID(c *gin.Context) (string, error) {
@@ -20,3 +21,8 @@ func GetUserID(c *gin.Context) (string, error) {
 
 	return uIDStr, nil
 }
+
+// Accept-Language 헤더로 정한 언어. 유저 언어 설정이 있으면 서비스에서 그쪽을 우선함
+func GetLocale(c *gin.Context) string {
+	return utils.ParseAcceptLanguage(c.GetHeader("Accept-Language"))
+}
@@ -0,0 +1,118 @@
+// api/handlers/catalog.go
+
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/api/services"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/response"
+)
+
+type CatalogHandler struct {
+	catalogService services.CatalogService
+}
+
+func NewCatalogHandler(cs services.CatalogService) *CatalogHandler {
+	return &CatalogHandler{
+		catalogService: cs,
+	}
+}
+
+// @Summary 커스텀 음식 승격 대기열 조회
+// @Description 관리자 권한으로 리뷰 수가 많은 순서대로 커스텀 음식 목록을 조회한다.
+// @Tags Admin
+// @Produce json
+// @Param page query int false "페이지 (기본 1)"
+// @Param count query int false "페이지당 개수 (기본 20, 최대 100)"
+// @Success 200 {object} response.Response{data=[]models.CustomFood} "조회 성공"
+// @Router /admin/custom-foods [get]
+func (h *CatalogHandler) GetCustomFoodQueue(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid page query parameter", err))
+		return
+	}
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "20"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid count query parameter", err))
+		return
+	}
+
+	foods, err := h.catalogService.GetCustomFoodQueue(c, page, count)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    foods,
+	})
+}
+
+// @Summary 커스텀 음식 승격
+// @Description 관리자 권한으로 커스텀 음식을 표준 음식으로 승격하고, 기존 리뷰의 음식 항목과 리뷰 통계를 옮긴다. allergens는 표준 음식 생성과 같은 규칙을 따른다.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param foodID path string true "커스텀 음식 ID"
+// @Param request body models.PromoteCustomFoodRequest true "표준 음식 정보"
+// @Success 201 {object} response.Response{data=models.StandardFood} "승격 성공"
+// @Failure 404 {object} response.Response "커스텀 음식을 찾을 수 없음"
+// @Failure 409 {object} response.Response "같은 이름의 표준 음식이 이미 존재"
+// @Router /admin/custom-foods/{foodID}/promote [post]
+func (h *CatalogHandler) PromoteCustomFood(c *gin.Context) {
+	var req models.PromoteCustomFoodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	foodID := c.Param("foodID")
+
+	newFood, err := h.catalogService.PromoteCustomFood(c, foodID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.Response{
+		Success: true,
+		Data:    newFood,
+	})
+}
+
+// @Summary 중복 음식 병합
+// @Description 관리자 권한으로 같은 타입의 두 음식을 병합한다. source의 리뷰 항목, 좋아요, 추천 기록을 target으로 옮기고 통계를 다시 계산한 뒤, source ID는 target으로 리다이렉트된다.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body models.MergeFoodsRequest true "병합 정보 (type: standard/custom)"
+// @Success 200 {object} response.Response{data=models.MergeFoodsResponse} "병합 성공"
+// @Failure 400 {object} response.Response "잘못된 ID 또는 타입"
+// @Failure 404 {object} response.Response "음식을 찾을 수 없음"
+// @Router /admin/foods/merge [post]
+func (h *CatalogHandler) MergeFoods(c *gin.Context) {
+	var req models.MergeFoodsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	result, err := h.catalogService.MergeFoods(c, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    result,
+	})
+}
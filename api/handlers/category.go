@@ -0,0 +1,72 @@
+// api/handlers/category.go
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/api/services"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/response"
+)
+
+type CategoryHandler struct {
+	categoryService services.CategoryService
+}
+
+func NewCategoryHandler(cs services.CategoryService) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: cs,
+	}
+}
+
+// @Summary 카테고리 트리 조회
+// @Description 카테고리를 트리 형태로 정렬해 하위 카테고리를 포함한 음식 개수와 함께 반환한다. 이름은 Accept-Language에 맞춰 번역된다.
+// @Tags Category
+// @Produce json
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=[]models.CategoryNode} "조회 성공"
+// @Router /categories [get]
+func (h *CategoryHandler) GetCategoryTree(c *gin.Context) {
+	tree, err := h.categoryService.GetTree(c, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    tree,
+	})
+}
+
+// @Summary 카테고리 생성
+// @Description 관리자 권한으로 새로운 카테고리들을 생성한다.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body []models.CreateCategoryRequest true "카테고리 정보 목록"
+// @Success 201 {object} response.Response{data=[]models.Category} "카테고리 생성 성공"
+// @Failure 400 {object} response.Response "존재하지 않는 상위 카테고리"
+// @Failure 409 {object} response.Response "이미 존재하는 카테고리"
+// @Router /admin/categories [post]
+func (h *CategoryHandler) CreateCategories(c *gin.Context) {
+	var req []models.CreateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	newCategories, err := h.categoryService.CreateCategories(c, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.Response{
+		Success: true,
+		Data:    newCategories,
+	})
+}
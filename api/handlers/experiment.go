@@ -0,0 +1,131 @@
+// api/handlers/experiment.go
+
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/api/services"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/response"
+)
+
+type ExperimentHandler struct {
+	experimentService services.ExperimentService
+}
+
+func NewExperimentHandler(es services.ExperimentService) *ExperimentHandler {
+	return &ExperimentHandler{
+		experimentService: es,
+	}
+}
+
+// @Summary 추천 실험 생성
+// @Description 관리자 권한으로 메인 피드 추천 A/B 실험을 draft 상태로 만든다. 변형마다 트래픽 비율(합 100)과 기본 추천 설정을 덮어쓸 값을 지정하며, 사용자는 ID 기준으로 항상 같은 변형에 배정된다.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body models.CreateExperimentRequest true "실험 정보"
+// @Success 201 {object} response.Response{data=models.Experiment} "생성 성공"
+// @Failure 400 {object} response.Response "잘못된 이름, 비율 또는 설정 값"
+// @Failure 409 {object} response.Response "같은 이름의 실험이 이미 존재"
+// @Router /admin/experiments [post]
+func (h *ExperimentHandler) CreateExperiment(c *gin.Context) {
+	var req models.CreateExperimentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	experiment, err := h.experimentService.CreateExperiment(c, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.Response{
+		Success: true,
+		Data:    experiment,
+	})
+}
+
+// @Summary 추천 실험 목록 조회
+// @Description 관리자 권한으로 모든 추천 실험을 최근 생성 순으로 조회한다.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} response.Response{data=[]models.Experiment} "조회 성공"
+// @Router /admin/experiments [get]
+func (h *ExperimentHandler) GetExperiments(c *gin.Context) {
+	experiments, err := h.experimentService.GetExperiments(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    experiments,
+	})
+}
+
+// @Summary 추천 실험 시작/종료
+// @Description 관리자 권한으로 실험을 시작(running)하거나 종료(stopped)한다. draft -> running -> stopped 순서로만 바꿀 수 있고, 동시에 하나의 실험만 실행할 수 있다.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param name path string true "실험 이름"
+// @Param request body models.UpdateExperimentStatusRequest true "바꿀 상태 (running/stopped)"
+// @Success 200 {object} response.Response{data=models.Experiment} "변경 성공"
+// @Failure 404 {object} response.Response "실험을 찾을 수 없음"
+// @Failure 409 {object} response.Response "바꿀 수 없는 상태이거나 다른 실험이 실행 중"
+// @Router /admin/experiments/{name}/status [patch]
+func (h *ExperimentHandler) UpdateStatus(c *gin.Context) {
+	var req models.UpdateExperimentStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	experiment, err := h.experimentService.UpdateStatus(c, c.Param("name"), req.Status)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    experiment,
+	})
+}
+
+// @Summary 추천 실험 결과 조회
+// @Description 관리자 권한으로 변형별 추천 노출 수와, 추천 후 windowHours 안에 좋아요/리뷰로 이어진 비율을 조회한다. 실험 노출은 90일간 보관되므로 최근 90일 이내의 노출만 집계된다.
+// @Tags Admin
+// @Produce json
+// @Param name path string true "실험 이름"
+// @Param windowHours query int false "전환으로 인정할 시간 (기본 24, 최대 72)"
+// @Success 200 {object} response.Response{data=models.ExperimentReport} "조회 성공"
+// @Failure 400 {object} response.Response "시작하지 않은 실험"
+// @Failure 404 {object} response.Response "실험을 찾을 수 없음"
+// @Router /admin/experiments/{name}/report [get]
+func (h *ExperimentHandler) GetReport(c *gin.Context) {
+	windowHours, err := strconv.Atoi(c.DefaultQuery("windowHours", "24"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid window hours", err))
+		return
+	}
+
+	report, err := h.experimentService.GetReport(c, c.Param("name"), windowHours)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    report,
+	})
+}
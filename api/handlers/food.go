@@ -24,11 +24,12 @@ func NewFoodHandler(foodService services.FoodService) *FoodHandler {
 }
 
 // @Summary 표준 음식 조회
-// @Description 음식 ID를 통해 표준 음식 정보를 조회한다.
+// @Description 음식 ID를 통해 표준 음식 정보를 조회한다. 이름과 설명은 Accept-Language에 맞춰 번역된다.
 // @Tags Food
 // @Accept json
 // @Produce json
 // @Param foodID path string true "음식 ID"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
 // @Success 200 {object} response.Response{data=models.StandardFood} "음식 조회 성공"
 // @Failure 400 {object} response.Response "잘못된 ID 형식"
 // @Failure 404 {object} response.Response "음식을 찾을 수 없음"
@@ -36,7 +37,7 @@ func NewFoodHandler(foodService services.FoodService) *FoodHandler {
 func (h *FoodHandler) GetStandardFoodByID(c *gin.Context) {
 	foodID := c.Param("foodID")
 
-	food, err := h.foodService.GetStandardByID(c, foodID)
+	food, err := h.foodService.GetStandardByID(c, foodID, GetLocale(c))
 	if err != nil {
 		c.Error(err)
 		return
@@ -49,7 +50,7 @@ func (h *FoodHandler) GetStandardFoodByID(c *gin.Context) {
 }
 
 // @Summary 표준 음식 생성
-// @Description 관리자 권한으로 새로운 표준 음식들을 생성한다.
+// @Description 관리자 권한으로 새로운 표준 음식들을 생성한다. allergens를 생략한 음식은 알레르기 확인 전 음식으로 남아 알레르기가 있는 사용자에게 추천되지 않으므로, 알레르기 유발 물질이 없으면 빈 배열로 보낸다.
 // @Tags Admin
 // @Accept json
 // @Produce json
@@ -76,7 +77,7 @@ func (h *FoodHandler) CreateStandardFoods(c *gin.Context) {
 }
 
 // @Summary 리뷰용 음식 반환
-// @Description 음식 이름들을 받아 리뷰용 음식 항목들을 생성 및 반환한다.
+// @Description 음식 이름들을 받아 리뷰용 음식 항목들을 생성 및 반환한다. 번역된 이름도 표준 음식으로 연결되며, 항목에는 기본(한국어) 이름이 저장된다.
 // @Tags Food
 // @Accept json
 // @Produce json
@@ -104,13 +105,16 @@ func (h *FoodHandler) ResolveFoodItems(c *gin.Context) {
 }
 
 // @Summary 카뉴 음식 조회
-// @Description 유저에게 추천한 기록을 바탕으로 카뉴 음식 목록을 가져온다.
+// @Description 유저에게 추천한 기록을 바탕으로 카뉴 음식 목록을 가져온다. 식단 프로필에 맞지 않는 음식은 제외되며, 그 때문에 개수를 못 채우면 meta.limitedByProfile이 true가 된다. meta.recommendationID는 추천 반응 기록(POST /recommendations/events)에 사용한다. 각 음식의 reasons에는 추천 이유 코드(not_shown_recently, similar_to_liked, popular, matches_taste, new_category, fits_meal_time)가 담긴다. 식사 시간대(mealTime)를 주지 않으면 timezone의 현재 시각으로 정하며, 정해진 시간대는 meta.mealTime으로 돌려준다.
 // @Tags Food
 // @Accept json
 // @Produce json
 // @Param speed query string true "속도 (fast/slow)"
 // @Param count query int true "조회 개수 (최대 10개)"
-// @Success 200 {object} response.Response{data=[]models.FoodLikeResponse} "조회 성공"
+// @Param mealTime query string false "식사 시간대 (breakfast/lunch/dinner/late_night)"
+// @Param timezone query string false "사용자 시간대 (IANA, 기본 Asia/Seoul)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=[]models.FoodLikeResponse,meta=models.FeedMeta} "조회 성공"
 // @Security BearerAuth
 // @Router /foods/main-feed [get]
 func (h *FoodHandler) GetMainFeedFoods(c *gin.Context) {
@@ -129,7 +133,7 @@ func (h *FoodHandler) GetMainFeedFoods(c *gin.Context) {
 		return
 	}
 
-	result, err := h.foodService.GetMainFeedFoods(c, userID, speed, count)
+	result, err := h.foodService.GetMainFeedFoods(c, userID, speed, count, c.Query("mealTime"), c.Query("timezone"), GetLocale(c))
 	if err != nil {
 		c.Error(err)
 		return
@@ -137,19 +141,21 @@ func (h *FoodHandler) GetMainFeedFoods(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response.Response{
 		Success: true,
-		Data:    result,
+		Data:    result.Foods,
+		Meta:    result.Meta,
 	})
 }
 
 // @Summary 카테고리별 음식 조회
-// @Description 카테고리별 음식 목록을 랜덤하게 가져온다.
+// @Description 카테고리별 음식 목록을 랜덤하게 가져온다. 상위 카테고리를 고르면 하위 카테고리의 음식도 포함되며, 식단 프로필에 맞지 않는 음식은 제외된다.
 // @Tags Food
 // @Accept json
 // @Produce json
 // @Param speed query string true "속도 (fast/slow)"
 // @Param category query []string true "카테고리 목록 (1개 이상)"
 // @Param count query int true "조회 개수 (최대 10개)"
-// @Success 200 {object} response.Response{data=[]models.FoodLikeResponse} "조회 성공"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=[]models.FoodLikeResponse,meta=models.FeedMeta} "조회 성공"
 // @Security BearerAuth
 // @Router /foods/category [get]
 func (h *FoodHandler) GetFoodsByCategories(c *gin.Context) {
@@ -169,7 +175,177 @@ func (h *FoodHandler) GetFoodsByCategories(c *gin.Context) {
 		return
 	}
 
-	result, err := h.foodService.GetFoodsByCategories(c, userID, speed, categories, count)
+	result, err := h.foodService.GetFoodsByCategories(c, userID, speed, categories, count, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    result.Foods,
+		Meta:    result.Meta,
+	})
+}
+
+// @Summary 함께 먹을 음식 추천
+// @Description 공유 코드(code)로 모인 멤버 또는 요청자와 userID로 지정한 사용자들(최대 10명, 요청자와 같은 모임의 멤버여야 함)이 함께 먹을 음식을 추천한다. 모두의 식단 프로필과 숨김을 지키고, 누구에게든 최근 추천됐거나 최근 먹은 음식은 제외하며, 멤버들의 취향을 합쳐 한 명이라도 싫어하는 음식은 뒤로 밀린다. 추천 기록은 멤버마다 남는다. 각 음식의 reasons에는 group_match(좋아하는 멤버 수는 count) 등의 추천 이유가 담긴다.
+// @Tags Food
+// @Produce json
+// @Param code query string false "모임 공유 코드"
+// @Param userID query []string false "함께 먹을 사용자 ID 목록 (code가 없을 때)"
+// @Param speed query string true "속도 (fast/slow)"
+// @Param count query int true "조회 개수 (최대 10개)"
+// @Param mealTime query string false "식사 시간대 (breakfast/lunch/dinner/late_night)"
+// @Param timezone query string false "사용자 시간대 (IANA, 기본 Asia/Seoul)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=[]models.FoodLikeResponse,meta=models.FeedMeta} "조회 성공"
+// @Failure 400 {object} response.Response "인원이 2명 미만이거나 10명 초과"
+// @Failure 403 {object} response.Response "모임의 멤버가 아니거나, 지정한 사용자들이 요청자와 같은 모임에 없음"
+// @Failure 404 {object} response.Response "모임이나 사용자를 찾을 수 없음"
+// @Security BearerAuth
+// @Router /foods/group-feed [get]
+func (h *FoodHandler) GetGroupFeedFoods(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	count, err := strconv.Atoi(c.Query("count"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid food count", err))
+		return
+	}
+
+	query := models.GroupFeedQuery{
+		Code:     c.Query("code"),
+		UserIDs:  c.QueryArray("userID"),
+		Speed:    c.Query("speed"),
+		Count:    count,
+		MealTime: c.Query("mealTime"),
+		Timezone: c.Query("timezone"),
+	}
+
+	result, err := h.foodService.GetGroupFeedFoods(c, userID, query, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    result.Foods,
+		Meta:    result.Meta,
+	})
+}
+
+// @Summary 표준 음식 영양 정보 수정
+// @Description 관리자 권한으로 표준 음식의 1인분 기준 영양 정보를 설정한다. nutrition을 비워 보내면 영양 정보를 제거한다.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param foodID path string true "음식 ID"
+// @Param request body models.UpdateNutritionRequest true "영양 정보"
+// @Success 200 {object} response.Response{data=models.StandardFood} "수정 성공"
+// @Failure 404 {object} response.Response "음식을 찾을 수 없음"
+// @Router /admin/standard-foods/{foodID}/nutrition [put]
+func (h *FoodHandler) UpdateNutrition(c *gin.Context) {
+	var req models.UpdateNutritionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	foodID := c.Param("foodID")
+
+	food, err := h.foodService.UpdateNutrition(c, foodID, req.Nutrition)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    food,
+	})
+}
+
+// @Summary 표준 음식 정보 수정
+// @Description 관리자 권한으로 표준 음식의 이미지, 알레르기 유발 물질, 식단 태그, 속성, 설명, 번역, 재료를 수정한다. 보낸 항목만 바뀌며 빈 값을 보내면 해당 정보를 지운다. allergens를 보내면(빈 배열 포함) 알레르기 확인이 끝난 음식으로 표시되며, 확인되지 않은 음식은 알레르기가 있는 사용자에게 추천되지 않는다.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param foodID path string true "음식 ID"
+// @Param request body models.UpdateStandardFoodRequest true "수정할 항목"
+// @Success 200 {object} response.Response{data=models.StandardFood} "수정 성공"
+// @Failure 400 {object} response.Response "잘못된 태그, 속성, 번역 또는 재료"
+// @Failure 404 {object} response.Response "음식을 찾을 수 없음"
+// @Router /admin/standard-foods/{foodID} [patch]
+func (h *FoodHandler) UpdateStandardDetails(c *gin.Context) {
+	var req models.UpdateStandardFoodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	foodID := c.Param("foodID")
+
+	food, err := h.foodService.UpdateStandardDetails(c, foodID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    food,
+	})
+}
+
+// @Summary 속성별 음식 탐색
+// @Description 맵기, 국물 여부, 주식 종류, 온도, 가격대 등 속성으로 음식을 필터링해 커서 기반으로 가져온다. 같은 속성 안의 값들은 OR, 속성끼리는 AND로 적용되며 속성 값별 음식 수를 함께 반환한다.
+// @Tags Food
+// @Produce json
+// @Param speed query string false "속도 (fast/slow)"
+// @Param spiciness[] query []string false "맵기 (none/mild/hot)"
+// @Param soup[] query []string false "국물 여부 (soup/dry)"
+// @Param base[] query []string false "주식 종류 (rice/noodle/bread/other)"
+// @Param temperature[] query []string false "온도 (hot/cold)"
+// @Param price[] query []string false "가격대 (low/mid/high)"
+// @Param cursor query string false "이전 응답의 nextCursor"
+// @Param count query int false "조회 개수 (기본 20, 최대 30)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=models.BrowseFoodsResponse} "조회 성공"
+// @Security BearerAuth
+// @Router /foods/browse [get]
+func (h *FoodHandler) BrowseFoods(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "20"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid food count", err))
+		return
+	}
+
+	attributes := make(map[string][]string)
+	for _, key := range models.FoodAttributeKeys {
+		if values := c.QueryArray(key + "[]"); len(values) > 0 {
+			attributes[key] = values
+		}
+	}
+
+	result, err := h.foodService.BrowseFoods(c, userID, models.BrowseFoodsQuery{
+		Speed:      c.Query("speed"),
+		Attributes: attributes,
+		Cursor:     c.Query("cursor"),
+		Count:      count,
+		Locale:     GetLocale(c),
+	})
 	if err != nil {
 		c.Error(err)
 		return
@@ -180,3 +356,183 @@ func (h *FoodHandler) GetFoodsByCategories(c *gin.Context) {
 		Data:    result,
 	})
 }
+
+// @Summary 음식 검색
+// @Description 기본 이름과 번역된 이름에서 검색어를 포함하는 표준 음식을 리뷰 수 순으로 가져온다. 식단 프로필에 맞지 않는 음식은 제외된다.
+// @Tags Food
+// @Accept json
+// @Produce json
+// @Param q query string true "검색어"
+// @Param count query int false "조회 개수 (기본 20, 최대 30)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=[]models.FoodLikeResponse} "검색 성공"
+// @Security BearerAuth
+// @Router /foods/search [get]
+func (h *FoodHandler) SearchFoods(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "20"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid food count", err))
+		return
+	}
+
+	foods, err := h.foodService.SearchFoods(c, userID, c.Query("q"), count, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    foods,
+	})
+}
+
+// @Summary 음식 상세 조회
+// @Description 음식 정보와 함께 평균 평점, 1~5점 분포, 식사 시간대별 리뷰 수, 좋아요 수, 최신 리뷰 코멘트를 가져온다. 이후 리뷰는 nextCursor로 /foods/{foodID}/reviews에서 이어서 조회한다.
+// @Tags Food
+// @Accept json
+// @Produce json
+// @Param foodID path string true "음식 ID"
+// @Param reviewCount query int false "함께 가져올 리뷰 수 (기본 5, 최대 20)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=models.FoodDetailResponse} "조회 성공"
+// @Failure 404 {object} response.Response "음식을 찾을 수 없음"
+// @Security BearerAuth
+// @Router /foods/{foodID}/detail [get]
+func (h *FoodHandler) GetFoodDetail(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	reviewCount, err := strconv.Atoi(c.DefaultQuery("reviewCount", "5"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid review count", err))
+		return
+	}
+
+	detail, err := h.foodService.GetFoodDetail(c, userID, c.Param("foodID"), reviewCount, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    detail,
+	})
+}
+
+// @Summary 음식 리뷰 목록 조회
+// @Description 해당 음식이 포함된 리뷰 중 코멘트가 있는 것을 최신순으로 커서 기반으로 가져온다. 작성자 정보는 포함하지 않는다.
+// @Tags Food
+// @Accept json
+// @Produce json
+// @Param foodID path string true "음식 ID"
+// @Param cursor query string false "이전 응답의 nextCursor"
+// @Param count query int false "조회 개수 (기본 10, 최대 20)"
+// @Success 200 {object} response.Response{data=models.ReviewSnippetPage} "조회 성공"
+// @Failure 404 {object} response.Response "음식을 찾을 수 없음"
+// @Security BearerAuth
+// @Router /foods/{foodID}/reviews [get]
+func (h *FoodHandler) GetFoodReviews(c *gin.Context) {
+	count, err := strconv.Atoi(c.DefaultQuery("count", "10"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid review count", err))
+		return
+	}
+
+	page, err := h.foodService.GetFoodReviews(c, c.Param("foodID"), c.Query("cursor"), count)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    page,
+	})
+}
+
+// @Summary 비슷한 음식 조회
+// @Description 부모, 카테고리, 속성을 공유하거나 같은 리뷰에 함께 기록된 다른 표준 음식을 유사도 순으로 가져온다. 식단 프로필에 맞지 않는 음식은 제외된다.
+// @Tags Food
+// @Accept json
+// @Produce json
+// @Param foodID path string true "음식 ID"
+// @Param speed query string false "속도 (fast/slow)"
+// @Param count query int false "조회 개수 (기본 10, 최대 20)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=[]models.FoodLikeResponse} "조회 성공"
+// @Failure 404 {object} response.Response "음식을 찾을 수 없음"
+// @Security BearerAuth
+// @Router /foods/{foodID}/similar [get]
+func (h *FoodHandler) GetSimilarFoods(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "10"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid food count", err))
+		return
+	}
+
+	foods, err := h.foodService.GetSimilarFoods(c, userID, c.Param("foodID"), c.Query("speed"), count, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    foods,
+	})
+}
+
+// @Summary 냉장고 재료로 음식 추천
+// @Description 가진 재료를 받아 필요한 재료를 많이 갖춘 집밥(slow) 음식을 추천한다. 메인 피드처럼 최근 추천한 음식과 부모가 겹치는 음식은 뒤로 밀리며, 각 음식의 재료 충족률과 부족한 재료를 함께 반환한다.
+// @Tags Food
+// @Accept json
+// @Produce json
+// @Param request body models.FridgeRequest true "가진 재료 목록과 조회 개수 (기본 5, 최대 10)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=[]models.FridgeFood} "추천 성공"
+// @Security BearerAuth
+// @Router /foods/fridge [post]
+func (h *FoodHandler) GetFridgeFoods(c *gin.Context) {
+	var req models.FridgeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+	if req.Count == 0 {
+		req.Count = 5
+	}
+
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	foods, err := h.foodService.GetFridgeFoods(c, userID, req.Ingredients, req.Count, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    foods,
+	})
+}
@@ -0,0 +1,115 @@
+// api/handlers/hide.go
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/api/services"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/response"
+)
+
+type HideHandler struct {
+	hideService services.HideService
+}
+
+func NewHideHandler(hs services.HideService) *HideHandler {
+	return &HideHandler{
+		hideService: hs,
+	}
+}
+
+// @Summary 관심 없음 (음식 숨기기)
+// @Description 메인 피드와 카테고리 추천에서 음식(scope: food) 또는 그 음식의 부모 음식 전체(scope: parent)를 일주일(duration: week) 또는 해제할 때까지(duration: forever) 숨긴다. 이미 숨긴 대상이면 기간만 새로 덮어쓴다.
+// @Tags Food
+// @Accept json
+// @Produce json
+// @Param foodID path string true "음식 ID"
+// @Param request body models.CreateHideRequest true "숨김 범위와 기간"
+// @Success 200 {object} response.Response{data=[]models.Hide} "숨김 성공"
+// @Failure 400 {object} response.Response "잘못된 범위/기간이거나 부모가 없는 음식"
+// @Failure 404 {object} response.Response "음식을 찾을 수 없음"
+// @Security BearerAuth
+// @Router /foods/{foodID}/hides [post]
+func (h *HideHandler) HideFood(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req models.CreateHideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	hides, err := h.hideService.HideFood(c.Request.Context(), userID, c.Param("foodID"), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    hides,
+	})
+}
+
+// @Summary 숨긴 음식 목록 조회
+// @Description 현재 유저가 숨긴 음식과 부모 음식 중 아직 만료되지 않은 것을 최근 순으로 조회한다.
+// @Tags User
+// @Produce json
+// @Success 200 {object} response.Response{data=[]models.HideResponse} "숨김 목록"
+// @Security BearerAuth
+// @Router /users/me/hides [get]
+func (h *HideHandler) GetHides(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	result, err := h.hideService.GetHides(c.Request.Context(), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    result,
+	})
+}
+
+// @Summary 숨김 해제
+// @Description 숨긴 음식이나 부모 음식을 다시 추천에 나오게 한다.
+// @Tags User
+// @Produce json
+// @Param hideID path string true "숨김 ID"
+// @Success 200 {object} response.Response{data=string} "성공 메시지"
+// @Failure 400 {object} response.Response "잘못된 숨김 ID 형식"
+// @Failure 404 {object} response.Response "숨김 기록 없음"
+// @Security BearerAuth
+// @Router /users/me/hides/{hideID} [delete]
+func (h *HideHandler) Unhide(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.hideService.Unhide(c.Request.Context(), userID, c.Param("hideID"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    "hide removed successfully",
+	})
+}
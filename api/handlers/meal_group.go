@@ -0,0 +1,105 @@
+// api/handlers/meal_group.go
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/api/services"
+	"github.com/seojoonrp/bapddang-server/response"
+)
+
+type MealGroupHandler struct {
+	mealGroupService services.MealGroupService
+}
+
+func NewMealGroupHandler(mgs services.MealGroupService) *MealGroupHandler {
+	return &MealGroupHandler{
+		mealGroupService: mgs,
+	}
+}
+
+// @Summary 함께 먹을 모임 만들기
+// @Description 함께 먹을 사람을 모으는 공유 코드를 만든다. 만든 사람이 첫 멤버이며, 코드는 24시간 동안 유효하다.
+// @Tags Group
+// @Produce json
+// @Success 200 {object} response.Response{data=models.MealGroupResponse} "생성 성공"
+// @Security BearerAuth
+// @Router /groups [post]
+func (h *MealGroupHandler) CreateGroup(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	group, err := h.mealGroupService.CreateGroup(c.Request.Context(), userID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    group,
+	})
+}
+
+// @Summary 공유 코드로 모임 참여
+// @Description 공유 코드로 모임에 참여한다. 이미 멤버이면 그대로 성공한다.
+// @Tags Group
+// @Produce json
+// @Param code path string true "공유 코드"
+// @Success 200 {object} response.Response{data=models.MealGroupResponse} "참여 성공"
+// @Failure 404 {object} response.Response "모임이 없거나 만료됨"
+// @Failure 409 {object} response.Response "인원이 가득 참"
+// @Security BearerAuth
+// @Router /groups/{code}/members [post]
+func (h *MealGroupHandler) JoinGroup(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	group, err := h.mealGroupService.JoinGroup(c.Request.Context(), userID, c.Param("code"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    group,
+	})
+}
+
+// @Summary 모임 조회
+// @Description 모임의 멤버 목록과 만료 시각을 조회한다. 멤버만 조회할 수 있다.
+// @Tags Group
+// @Produce json
+// @Param code path string true "공유 코드"
+// @Success 200 {object} response.Response{data=models.MealGroupResponse} "조회 성공"
+// @Failure 403 {object} response.Response "모임의 멤버가 아님"
+// @Failure 404 {object} response.Response "모임이 없거나 만료됨"
+// @Security BearerAuth
+// @Router /groups/{code} [get]
+func (h *MealGroupHandler) GetGroup(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	group, err := h.mealGroupService.GetGroup(c.Request.Context(), userID, c.Param("code"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    group,
+	})
+}
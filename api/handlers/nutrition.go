@@ -0,0 +1,89 @@
+// api/handlers/nutrition.go
+
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/api/services"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/response"
+)
+
+type NutritionHandler struct {
+	nutritionService services.NutritionService
+}
+
+func NewNutritionHandler(ns services.NutritionService) *NutritionHandler {
+	return &NutritionHandler{
+		nutritionService: ns,
+	}
+}
+
+// @Summary 일일 영양 섭취 요약
+// @Description 특정 날짜에 기록한 리뷰의 음식들을 기준으로 영양 섭취량을 합산한다. 영양 정보가 없는 음식은 untrackedFoods로 반환된다.
+// @Tags Nutrition
+// @Produce json
+// @Param day query int true "조회할 날짜"
+// @Success 200 {object} response.Response{data=models.DailyNutritionSummary} "조회 성공"
+// @Security BearerAuth
+// @Router /users/me/nutrition [get]
+func (h *NutritionHandler) GetDailySummary(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	day, err := strconv.Atoi(c.Query("day"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid day query parameter", err))
+		return
+	}
+
+	summary, err := h.nutritionService.GetDailySummary(c, userID, day)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    summary,
+	})
+}
+
+// @Summary 주간 영양 섭취 요약
+// @Description 특정 주의 날짜별 영양 섭취량과 합계, 기록한 날 기준 일평균을 반환한다.
+// @Tags Nutrition
+// @Produce json
+// @Param week query int true "조회할 주차"
+// @Success 200 {object} response.Response{data=models.WeeklyNutritionSummary} "조회 성공"
+// @Security BearerAuth
+// @Router /users/me/nutrition/weekly [get]
+func (h *NutritionHandler) GetWeeklySummary(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	week, err := strconv.Atoi(c.Query("week"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid week query parameter", err))
+		return
+	}
+
+	summary, err := h.nutritionService.GetWeeklySummary(c, userID, week)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    summary,
+	})
+}
@@ -0,0 +1,96 @@
+// api/handlers/pairing.go
+
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/api/services"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/response"
+)
+
+type PairingHandler struct {
+	pairingService services.PairingService
+}
+
+func NewPairingHandler(ps services.PairingService) *PairingHandler {
+	return &PairingHandler{
+		pairingService: ps,
+	}
+}
+
+// @Summary 함께 먹는 음식 조회
+// @Description 최근 180일 동안 여러 음식이 담긴 리뷰들을 분석해 이 음식과 자주 함께 먹는 음식을 confidence 순으로 가져온다. 주기적으로 계산된 결과이며, 식단 프로필에 맞지 않는 음식은 제외된다.
+// @Tags Food
+// @Accept json
+// @Produce json
+// @Param foodID path string true "음식 ID"
+// @Param count query int false "조회 개수 (기본 5, 최대 20)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=[]models.PairedFood} "조회 성공"
+// @Failure 404 {object} response.Response "음식을 찾을 수 없음"
+// @Security BearerAuth
+// @Router /foods/{foodID}/pairings [get]
+func (h *PairingHandler) GetPairings(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "5"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid food count", err))
+		return
+	}
+
+	foods, err := h.pairingService.GetPairings(c, userID, c.Param("foodID"), count, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    foods,
+	})
+}
+
+// @Summary 리뷰 작성 중 함께 먹는 음식 추천
+// @Description 리뷰에 이미 담은 음식들과 자주 함께 먹는 음식을 추천한다. 담은 음식은 결과에서 제외된다.
+// @Tags Review
+// @Accept json
+// @Produce json
+// @Param foodID[] query []string true "리뷰에 담은 표준 음식 ID 목록"
+// @Param count query int false "조회 개수 (기본 5, 최대 20)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=[]models.PairedFood} "조회 성공"
+// @Security BearerAuth
+// @Router /reviews/suggestions [get]
+func (h *PairingHandler) SuggestForComposer(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "5"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid food count", err))
+		return
+	}
+
+	foods, err := h.pairingService.SuggestForComposer(c, userID, c.QueryArray("foodID[]"), count, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    foods,
+	})
+}
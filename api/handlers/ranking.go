@@ -0,0 +1,99 @@
+// api/handlers/ranking.go
+
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/api/services"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/response"
+)
+
+type RankingHandler struct {
+	rankingService services.RankingService
+}
+
+func NewRankingHandler(rs services.RankingService) *RankingHandler {
+	return &RankingHandler{
+		rankingService: rs,
+	}
+}
+
+// @Summary 음식 순위 조회
+// @Description 베이지안 평균 평점 순위(rating) 또는 좋아요 순위(likes)를 기간별로 가져온다. 순위는 주기적으로 계산된 스냅샷이며, 식단 프로필에 맞지 않는 음식은 제외된다.
+// @Tags Food
+// @Accept json
+// @Produce json
+// @Param type query string false "순위 종류 (rating/likes, 기본 rating)"
+// @Param period query string false "기간 (week/month/all, 기본 week)"
+// @Param count query int false "조회 개수 (기본 20, 최대 50)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=models.RankingResponse} "조회 성공"
+// @Security BearerAuth
+// @Router /foods/rankings [get]
+func (h *RankingHandler) GetRankings(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "20"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid food count", err))
+		return
+	}
+
+	kind := c.DefaultQuery("type", models.RankingKindRating)
+	period := c.DefaultQuery("period", models.RankingPeriodWeek)
+
+	result, err := h.rankingService.GetRankings(c, userID, kind, period, count, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    result,
+	})
+}
+
+// @Summary 급상승 음식 조회
+// @Description 최근 3일간의 리뷰와 좋아요 활동을 시간에 따라 감쇠해 합산한 점수 순으로 요즘 많이 먹는 음식을 가져온다. 주기적으로 계산된 스냅샷이며, 식단 프로필에 맞지 않는 음식은 제외된다.
+// @Tags Food
+// @Accept json
+// @Produce json
+// @Param count query int false "조회 개수 (기본 10, 최대 50)"
+// @Param Accept-Language header string false "언어 (ko/en/ja/zh, 기본 ko)"
+// @Success 200 {object} response.Response{data=models.RankingResponse} "조회 성공"
+// @Security BearerAuth
+// @Router /foods/trending [get]
+func (h *RankingHandler) GetTrending(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "10"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid food count", err))
+		return
+	}
+
+	result, err := h.rankingService.GetTrending(c, userID, count, GetLocale(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    result,
+	})
+}
@@ -0,0 +1,93 @@
+// api/handlers/recommendation.go
+
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/api/services"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/response"
+)
+
+type RecommendationHandler struct {
+	recommendationService services.RecommendationService
+}
+
+func NewRecommendationHandler(rs services.RecommendationService) *RecommendationHandler {
+	return &RecommendationHandler{
+		recommendationService: rs,
+	}
+}
+
+// @Summary 추천 반응 기록
+// @Description 메인 피드에서 추천받은 음식을 눌렀거나(click), 피드에서 좋아요를 눌렀거나(like), 추천 후 일정 시간 안에 리뷰로 남겼을 때(review) 기록한다. recommendationID는 메인 피드 응답의 meta.recommendationID이며, 같은 반응을 여러 번 보내도 한 번만 집계된다.
+// @Tags Recommendation
+// @Accept json
+// @Produce json
+// @Param request body models.CreateRecEventRequest true "반응 정보 (type: click/like/review)"
+// @Success 200 {object} response.Response "기록 성공"
+// @Failure 400 {object} response.Response "추천에 없던 음식이거나 좋아요/리뷰가 확인되지 않음"
+// @Failure 404 {object} response.Response "추천 기록을 찾을 수 없음"
+// @Security BearerAuth
+// @Router /recommendations/events [post]
+func (h *RecommendationHandler) RecordEvent(c *gin.Context) {
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var req models.CreateRecEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	err = h.recommendationService.RecordEvent(c, userID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+	})
+}
+
+// @Summary 추천 클릭률 조회
+// @Description 관리자 권한으로 최근 days일 동안의 음식별(food) 또는 사용자별(user) 추천 노출 수와 클릭·좋아요·리뷰 수, 클릭률을 노출 수 순으로 조회한다.
+// @Tags Admin
+// @Produce json
+// @Param scope query string false "집계 단위 (food/user, 기본 food)"
+// @Param days query int false "기간 (기본 14, 최대 30)"
+// @Param count query int false "조회 개수 (기본 50, 최대 100)"
+// @Success 200 {object} response.Response{data=[]models.CTRStat} "조회 성공"
+// @Router /admin/recommendations/ctr [get]
+func (h *RecommendationHandler) GetCTR(c *gin.Context) {
+	days, err := strconv.Atoi(c.DefaultQuery("days", "14"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid days", err))
+		return
+	}
+
+	count, err := strconv.Atoi(c.DefaultQuery("count", "50"))
+	if err != nil {
+		c.Error(apperr.BadRequest("invalid count", err))
+		return
+	}
+
+	stats, err := h.recommendationService.GetCTR(c, c.DefaultQuery("scope", models.RecStatScopeFood), days, count)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    stats,
+	})
+}
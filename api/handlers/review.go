@@ -26,7 +26,7 @@ func NewReviewHandler(reviewService services.ReviewService, foodService services
 }
 
 // @Summary 리뷰 생성
-// @Description 새로운 리뷰를 생성한다.
+// @Description 새로운 리뷰를 생성한다. imageURL은 이미지 업로드 API가 반환한 URL만 허용된다.
 // @Tags Review
 // @Accept json
 // @Produce json
@@ -62,7 +62,7 @@ func (h *ReviewHandler) Create(c *gin.Context) {
 }
 
 // @Summary 리뷰 수정
-// @Description 기존 리뷰 정보를 수정한다.
+// @Description 기존 리뷰 정보를 수정한다. 새 imageURL은 이미지 업로드 API가 반환한 URL만 허용된다.
 // @Tags Review
 // @Accept json
 // @Produce json
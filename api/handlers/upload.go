@@ -0,0 +1,111 @@
+// api/handlers/upload.go
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/api/services"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/config"
+	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/response"
+)
+
+// multipart 경계와 다른 필드를 위한 여유분
+const multipartOverhead = 1 << 20
+
+type UploadHandler struct {
+	uploadService services.UploadService
+}
+
+func NewUploadHandler(us services.UploadService) *UploadHandler {
+	return &UploadHandler{
+		uploadService: us,
+	}
+}
+
+// multipart의 image 필드를 크기 제한 안에서 읽음
+func readImageFile(c *gin.Context) ([]byte, error) {
+	maxBytes := config.AppConfig.MaxUploadBytes
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes+multipartOverhead)
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return nil, apperr.PayloadTooLarge("image file is too large", err)
+		}
+		return nil, apperr.BadRequest("image file is required", err)
+	}
+	if fileHeader.Size > maxBytes {
+		return nil, apperr.PayloadTooLarge("image file is too large", nil)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, apperr.BadRequest("failed to open image file", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxBytes+1))
+	if err != nil {
+		return nil, apperr.BadRequest("failed to read image file", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, apperr.PayloadTooLarge("image file is too large", nil)
+	}
+
+	return data, nil
+}
+
+func (h *UploadHandler) upload(c *gin.Context, purpose string) {
+	data, err := readImageFile(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	image, err := h.uploadService.UploadImage(c, purpose, data)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.Response{
+		Success: true,
+		Data:    image,
+	})
+}
+
+// @Summary 리뷰 이미지 업로드
+// @Description 리뷰에 첨부할 이미지를 업로드한다. JPEG/PNG/GIF만 허용되며, 메타데이터(EXIF)를 제거하고 원본과 썸네일을 만들어 URL을 반환한다. 반환된 url을 리뷰의 imageURL로 사용한다.
+// @Tags Upload
+// @Accept multipart/form-data
+// @Produce json
+// @Param image formData file true "이미지 파일 (기본 최대 10MB)"
+// @Success 201 {object} response.Response{data=models.UploadedImage} "업로드 성공"
+// @Failure 413 {object} response.Response "파일 또는 해상도가 너무 큼"
+// @Failure 415 {object} response.Response "지원하지 않는 이미지 형식"
+// @Security BearerAuth
+// @Router /uploads/images [post]
+func (h *UploadHandler) UploadReviewImage(c *gin.Context) {
+	h.upload(c, models.UploadPurposeReview)
+}
+
+// @Summary 음식 이미지 업로드
+// @Description 관리자 권한으로 표준 음식 이미지를 업로드한다. 반환된 url을 음식 생성/승격 요청의 imageURL로 사용한다.
+// @Tags Admin
+// @Accept multipart/form-data
+// @Produce json
+// @Param image formData file true "이미지 파일 (기본 최대 10MB)"
+// @Success 201 {object} response.Response{data=models.UploadedImage} "업로드 성공"
+// @Failure 413 {object} response.Response "파일 또는 해상도가 너무 큼"
+// @Failure 415 {object} response.Response "지원하지 않는 이미지 형식"
+// @Router /admin/uploads/images [post]
+func (h *UploadHandler) UploadFoodImage(c *gin.Context) {
+	h.upload(c, models.UploadPurposeFood)
+}
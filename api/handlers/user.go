@@ -242,6 +242,76 @@ func (h *UserHandler) AgreeTerms(c *gin.Context) {
 	})
 }
 
+// @Summary 식단 프로필 수정
+// @Description 알레르기 유발 물질과 식단(vegetarian/vegan/pescatarian/halal)을 설정한다. 추천과 카테고리 조회에서 맞지 않는 음식은 제외된다.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body models.UpdateDietaryProfileRequest true "식단 프로필"
+// @Success 200 {object} response.Response{data=models.DietaryProfile} "수정 성공"
+// @Failure 400 {object} response.Response "지원하지 않는 알레르기 또는 식단"
+// @Security BearerAuth
+// @Router /users/me/dietary-profile [put]
+func (h *UserHandler) UpdateDietaryProfile(c *gin.Context) {
+	var req models.UpdateDietaryProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	profile, err := h.userService.UpdateDietaryProfile(c, userID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    profile,
+	})
+}
+
+// @Summary 언어 설정
+// @Description 음식과 카테고리 이름을 표시할 언어(ko/en/ja/zh)를 설정한다. 빈 값이면 설정을 지우고 Accept-Language를 따른다.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body models.UpdateLocaleRequest true "언어"
+// @Success 200 {object} response.Response{data=string} "성공 메시지"
+// @Failure 400 {object} response.Response "지원하지 않는 언어"
+// @Security BearerAuth
+// @Router /users/me/locale [patch]
+func (h *UserHandler) UpdateLocale(c *gin.Context) {
+	var req models.UpdateLocaleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apperr.BadRequest("invalid request body", err))
+		return
+	}
+
+	userID, err := GetUserID(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	err = h.userService.UpdateLocale(c, userID, req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.Response{
+		Success: true,
+		Data:    "locale updated successfully",
+	})
+}
+
 // @Summary 회원 탈퇴
 // @Description 현재 로그인한 유저의 계정을 삭제한다.
 // @Tags User
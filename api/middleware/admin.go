@@ -0,0 +1,28 @@
+// api/middleware/admin.go
+// 관리자 API 보호 미들웨어
+
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/config"
+)
+
+// X-Admin-Key 헤더가 ADMIN_KEY와 일치해야 통과. ADMIN_KEY가 비어 있으면 모든 요청을 거부
+func AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adminKey := config.AppConfig.AdminKey
+		reqKey := c.GetHeader("X-Admin-Key")
+
+		if adminKey == "" || subtle.ConstantTimeCompare([]byte(reqKey), []byte(adminKey)) != 1 {
+			c.Error(apperr.Forbidden("admin access required", nil))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
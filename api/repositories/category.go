@@ -0,0 +1,69 @@
+// api/repositories/category.go
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CategoryRepository interface {
+	FindAll(ctx context.Context) ([]models.Category, error)
+	FindByKeys(ctx context.Context, keys []string) ([]models.Category, error)
+	CreateMany(ctx context.Context, categories []interface{}) error
+}
+
+type categoryRepository struct {
+	collection *mongo.Collection
+}
+
+func NewCategoryRepository(db *mongo.Database) CategoryRepository {
+	return &categoryRepository{
+		collection: db.Collection("categories"),
+	}
+}
+
+func (r *categoryRepository) FindAll(ctx context.Context) ([]models.Category, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "order", Value: 1}, {Key: "key", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	categories := []models.Category{}
+	if err := cursor.All(ctx, &categories); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepository) FindByKeys(ctx context.Context, keys []string) ([]models.Category, error) {
+	if len(keys) == 0 {
+		return []models.Category{}, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"key": bson.M{"$in": keys}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	categories := []models.Category{}
+	if err := cursor.All(ctx, &categories); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepository) CreateMany(ctx context.Context, categories []interface{}) error {
+	_, err := r.collection.InsertMany(ctx, categories)
+	return err
+}
@@ -0,0 +1,210 @@
+// api/repositories/experiment.go
+
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ExperimentRepository interface {
+	Create(ctx context.Context, experiment *models.Experiment) error
+	FindAll(ctx context.Context) ([]models.Experiment, error)
+	FindByName(ctx context.Context, name string) (*models.Experiment, error)
+	FindRunning(ctx context.Context) (*models.Experiment, error)
+	Start(ctx context.Context, experimentID primitive.ObjectID, at time.Time) (bool, error)
+	Stop(ctx context.Context, experimentID primitive.ObjectID, at time.Time) (bool, error)
+	SaveExposure(ctx context.Context, exposure models.ExperimentExposure) error
+	AggregateConversion(ctx context.Context, experiment string, since time.Time, window time.Duration) ([]models.VariantConversion, error)
+	DeleteExposuresByUserID(ctx context.Context, userID primitive.ObjectID) error
+	ReplaceExposureFoodID(ctx context.Context, oldFoodID, newFoodID primitive.ObjectID) error
+}
+
+type experimentRepository struct {
+	collection *mongo.Collection
+	exposures  *mongo.Collection
+}
+
+func NewExperimentRepository(db *mongo.Database) ExperimentRepository {
+	return &experimentRepository{
+		collection: db.Collection("experiments"),
+		exposures:  db.Collection("experiment_exposures"),
+	}
+}
+
+func (r *experimentRepository) Create(ctx context.Context, experiment *models.Experiment) error {
+	_, err := r.collection.InsertOne(ctx, experiment)
+	return err
+}
+
+func (r *experimentRepository) FindAll(ctx context.Context) ([]models.Experiment, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	experiments := []models.Experiment{}
+	if err := cursor.All(ctx, &experiments); err != nil {
+		return nil, err
+	}
+	return experiments, nil
+}
+
+func (r *experimentRepository) FindByName(ctx context.Context, name string) (*models.Experiment, error) {
+	var experiment models.Experiment
+	err := r.collection.FindOne(ctx, bson.M{"name": name}).Decode(&experiment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &experiment, nil
+}
+
+func (r *experimentRepository) FindRunning(ctx context.Context) (*models.Experiment, error) {
+	var experiment models.Experiment
+	err := r.collection.FindOne(ctx, bson.M{"status": models.ExperimentStatusRunning}).Decode(&experiment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &experiment, nil
+}
+
+// draft 상태일 때만 시작하며, 상태가 바뀌었는지 반환
+func (r *experimentRepository) Start(ctx context.Context, experimentID primitive.ObjectID, at time.Time) (bool, error) {
+	filter := bson.M{"_id": experimentID, "status": models.ExperimentStatusDraft}
+	update := bson.M{"$set": bson.M{"status": models.ExperimentStatusRunning, "started_at": at}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+// running 상태일 때만 종료하며, 상태가 바뀌었는지 반환
+func (r *experimentRepository) Stop(ctx context.Context, experimentID primitive.ObjectID, at time.Time) (bool, error) {
+	filter := bson.M{"_id": experimentID, "status": models.ExperimentStatusRunning}
+	update := bson.M{"$set": bson.M{"status": models.ExperimentStatusStopped, "stopped_at": at}}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+func (r *experimentRepository) SaveExposure(ctx context.Context, exposure models.ExperimentExposure) error {
+	_, err := r.exposures.InsertOne(ctx, exposure)
+	return err
+}
+
+// 실험 변형별로 추천된 음식이 추천 후 window 안에 좋아요나 리뷰로 이어졌는지 집계
+func (r *experimentRepository) AggregateConversion(ctx context.Context, experiment string, since time.Time, window time.Duration) ([]models.VariantConversion, error) {
+	windowMs := window.Milliseconds()
+	windowEnd := bson.M{"$add": bson.A{"$$shownAt", windowMs}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"experiment": experiment,
+			"created_at": bson.M{"$gte": since},
+		}}},
+		{{Key: "$unwind", Value: "$food_ids"}},
+		{{Key: "$lookup", Value: bson.M{
+			"from": "likes",
+			"let":  bson.M{"userID": "$user_id", "foodID": "$food_ids", "shownAt": "$created_at"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"$expr": bson.M{"$and": bson.A{
+					bson.M{"$eq": bson.A{"$user_id", "$$userID"}},
+					bson.M{"$eq": bson.A{"$food_id", "$$foodID"}},
+					bson.M{"$gte": bson.A{"$created_at", "$$shownAt"}},
+					bson.M{"$lt": bson.A{"$created_at", windowEnd}},
+				}}}},
+				bson.M{"$limit": 1},
+				bson.M{"$project": bson.M{"_id": 1}},
+			},
+			"as": "likes",
+		}}},
+		// 리뷰의 음식 ID는 문자열로 저장됨
+		{{Key: "$lookup", Value: bson.M{
+			"from": "reviews",
+			"let":  bson.M{"userID": "$user_id", "foodID": bson.M{"$toString": "$food_ids"}, "shownAt": "$created_at"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"$expr": bson.M{"$and": bson.A{
+					bson.M{"$eq": bson.A{"$user_id", "$$userID"}},
+					bson.M{"$gte": bson.A{"$created_at", "$$shownAt"}},
+					bson.M{"$lt": bson.A{"$created_at", windowEnd}},
+					bson.M{"$in": bson.A{"$$foodID", "$foods.food_id"}},
+				}}}},
+				bson.M{"$limit": 1},
+				bson.M{"$project": bson.M{"_id": 1}},
+			},
+			"as": "reviews",
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":         "$variant",
+			"users":       bson.M{"$addToSet": "$user_id"},
+			"feeds":       bson.M{"$addToSet": "$_id"},
+			"impressions": bson.M{"$sum": 1},
+			"liked": bson.M{"$sum": bson.M{"$cond": bson.A{
+				bson.M{"$gt": bson.A{bson.M{"$size": "$likes"}, 0}}, 1, 0,
+			}}},
+			"reviewed": bson.M{"$sum": bson.M{"$cond": bson.A{
+				bson.M{"$gt": bson.A{bson.M{"$size": "$reviews"}, 0}}, 1, 0,
+			}}},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"users":       bson.M{"$size": "$users"},
+			"feeds":       bson.M{"$size": "$feeds"},
+			"impressions": 1,
+			"liked":       1,
+			"reviewed":    1,
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+
+	// 노출이 90일간 쌓이므로 그룹 단계가 메모리 한도를 넘으면 디스크를 사용하도록 허용
+	cursor, err := r.exposures.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := []models.VariantConversion{}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *experimentRepository) DeleteExposuresByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	_, err := r.exposures.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
+
+func (r *experimentRepository) ReplaceExposureFoodID(ctx context.Context, oldFoodID, newFoodID primitive.ObjectID) error {
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{"id": oldFoodID}},
+	})
+
+	_, err := r.exposures.UpdateMany(
+		ctx,
+		bson.M{"food_ids": oldFoodID},
+		bson.M{"$set": bson.M{"food_ids.$[id]": newFoodID}},
+		opts,
+	)
+	return err
+}
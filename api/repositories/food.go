@@ -5,11 +5,14 @@ package repositories
 import (
 	"context"
 	"errors"
+	"regexp"
+	"sort"
 
 	"github.com/seojoonrp/bapddang-server/models"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type FoodRepository interface {
@@ -17,35 +20,145 @@ type FoodRepository interface {
 	FindStandardByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*models.StandardFood, error)
 	FindStandardByName(ctx context.Context, name string) (*models.StandardFood, error)
 	FindStandardByNames(ctx context.Context, names []string) ([]*models.StandardFood, error)
-	FindCustomByName(ctx context.Context, name string) (*models.CustomFood, error)
-	FindCustomByNames(ctx context.Context, names []string) ([]*models.CustomFood, error)
+	SearchStandards(ctx context.Context, query string, filter StandardFoodFilter, limit int) ([]models.StandardFood, error)
+	FindStandardsByIngredients(ctx context.Context, ingredients []string, filter StandardFoodFilter, limit int) ([]models.StandardFood, error)
+	FindSimilarCandidates(ctx context.Context, base *models.StandardFood, extraIDs []primitive.ObjectID, filter StandardFoodFilter, limit int) ([]models.StandardFood, error)
+	FindStandardsByIDsAndFilter(ctx context.Context, ids []primitive.ObjectID, filter StandardFoodFilter) ([]models.StandardFood, error)
+	FindCustomByNormalizedNames(ctx context.Context, normalizedNames []string) ([]*models.CustomFood, error)
+	FindCustomByID(ctx context.Context, id primitive.ObjectID) (*models.CustomFood, error)
+	FindCustomsByReviewCount(ctx context.Context, skip int64, limit int64) ([]models.CustomFood, error)
 
 	CreateStandards(ctx context.Context, foods []interface{}) error
+	CreateStandard(ctx context.Context, food *models.StandardFood) error
+	DeleteStandard(ctx context.Context, id primitive.ObjectID) error
 	CreateCustom(ctx context.Context, food models.CustomFood) error
-	CreateCustoms(ctx context.Context, foods []interface{}) error
-
-	GetRandomStandards(ctx context.Context, speed string, categories []string, count int) ([]models.StandardFood, error)
+	UpsertCustoms(ctx context.Context, foods []models.CustomFood) ([]string, error)
+	DeleteCustom(ctx context.Context, id primitive.ObjectID) error
+	AddCustomAliases(ctx context.Context, id primitive.ObjectID, aliases []string) error
+	ReserveCustomPromotion(ctx context.Context, id primitive.ObjectID, standardID primitive.ObjectID) (primitive.ObjectID, error)
+
+	GetRandomStandards(ctx context.Context, filter StandardFoodFilter, count int) ([]models.StandardFood, error)
+	CountStandards(ctx context.Context, filter StandardFoodFilter) (int64, error)
+	BrowseStandards(ctx context.Context, filter StandardFoodFilter, facetKeys []string, afterID primitive.ObjectID, limit int) ([]models.StandardFood, map[string]map[string]int, error)
+	FindStandardReviewStats(ctx context.Context) ([]models.FoodRatingStat, error)
+	FindStandardLikeCounts(ctx context.Context) (map[primitive.ObjectID]int, error)
+	CountStandardsByCategory(ctx context.Context) (map[string]int, error)
 
 	UpdateStandardCreatedReviewStats(ctx context.Context, foodIDs []primitive.ObjectID, rating int) error
 	UpdateStandardModifiedReviewStats(ctx context.Context, foodIDs []primitive.ObjectID, oldRating, newRating int) error
 	UpdateStandardDeletedReviewStats(ctx context.Context, foodIDs []primitive.ObjectID, rating int) error
 	UpdateCustomCreatedReviewStats(ctx context.Context, foodIDs []primitive.ObjectID) error
 	UpdateCustomDeletedReviewStats(ctx context.Context, foodIDs []primitive.ObjectID) error
+	SetStandardReviewStats(ctx context.Context, foodID primitive.ObjectID, reviewCount, totalRating int) error
+	SetStandardLikeCount(ctx context.Context, foodID primitive.ObjectID, likeCount int) error
+	UpdateStandardNutrition(ctx context.Context, foodID primitive.ObjectID, nutrition *models.Nutrition) error
+	UpdateStandardDetails(ctx context.Context, foodID primitive.ObjectID, req models.UpdateStandardFoodRequest) (*models.StandardFood, error)
+	SetCustomReviewCount(ctx context.Context, foodID primitive.ObjectID, reviewCount int) error
+
+	CreateRedirect(ctx context.Context, redirect models.FoodRedirect) error
+	FindRedirect(ctx context.Context, fromID primitive.ObjectID, foodType string) (*models.FoodRedirect, error)
+	RepointRedirects(ctx context.Context, oldToID primitive.ObjectID, newToID primitive.ObjectID, foodType string) error
 
 	IncrementLikeCount(ctx context.Context, foodID primitive.ObjectID) error
 	DecrementLikeCount(ctx context.Context, foodID primitive.ObjectID) error
 	DecrementLikeCounts(ctx context.Context, foodIDs []primitive.ObjectID) error
 }
 
+// 비어 있는 필드는 조건에서 제외
+type StandardFoodFilter struct {
+	Speed            string
+	Categories       []string
+	ExcludeAllergens []string
+	RequireDiets     []string
+	Attributes       map[string][]string
+
+	// 사용자가 숨긴 음식과 부모
+	ExcludeIDs     []primitive.ObjectID
+	ExcludeParents []string
+}
+
+func (f StandardFoodFilter) toBSON() bson.M {
+	query := f.withoutAttributes().baseBSON()
+	for key, values := range f.Attributes {
+		if len(values) > 0 {
+			query["attributes."+key] = bson.M{"$in": values}
+		}
+	}
+	return query
+}
+
+func (f StandardFoodFilter) withoutAttributes() StandardFoodFilter {
+	f.Attributes = nil
+	return f
+}
+
+// 같은 facet 안에서는 OR, facet끼리는 AND이며, skipKey facet의 조건은 제외
+func (f StandardFoodFilter) attributesBSON(skipKey string) bson.M {
+	query := bson.M{}
+	for key, values := range f.Attributes {
+		if key == skipKey || len(values) == 0 {
+			continue
+		}
+		query["attributes."+key] = bson.M{"$in": values}
+	}
+	return query
+}
+
+func (f StandardFoodFilter) baseBSON() bson.M {
+	query := bson.M{}
+
+	if f.Speed != "" {
+		query["speed"] = bson.M{"$in": []string{f.Speed, models.SpeedBoth}}
+	}
+	if len(f.Categories) > 0 {
+		query["categories"] = bson.M{"$in": f.Categories}
+	}
+	// 알레르기 정보를 확인하지 않은 음식은 무엇이 들었는지 모르므로 함께 제외
+	if len(f.ExcludeAllergens) > 0 {
+		query["allergens"] = bson.M{"$nin": f.ExcludeAllergens}
+		query["allergens_tagged"] = true
+	}
+	if len(f.RequireDiets) > 0 {
+		query["diet_tags"] = bson.M{"$all": f.RequireDiets}
+	}
+	if len(f.ExcludeIDs) > 0 {
+		query["_id"] = bson.M{"$nin": f.ExcludeIDs}
+	}
+	if len(f.ExcludeParents) > 0 {
+		query["parents"] = bson.M{"$nin": f.ExcludeParents}
+	}
+
+	return query
+}
+
+// 식단 프로필 조건을 뺀 필터
+func (f StandardFoodFilter) WithoutProfile() StandardFoodFilter {
+	f.ExcludeAllergens = nil
+	f.RequireDiets = nil
+	return f
+}
+
+// 필터의 _id 조건(숨긴 음식 제외)을 덮어쓰지 않고 조건을 더함
+func addIDCondition(query bson.M, op string, value interface{}) {
+	cond, ok := query["_id"].(bson.M)
+	if !ok {
+		cond = bson.M{}
+		query["_id"] = cond
+	}
+	cond[op] = value
+}
+
 type foodRepository struct {
 	standardFoodCollection *mongo.Collection
 	customFoodCollection   *mongo.Collection
+	redirectCollection     *mongo.Collection
 }
 
 func NewFoodRepository(db *mongo.Database) FoodRepository {
 	return &foodRepository{
 		standardFoodCollection: db.Collection("standard_foods"),
 		customFoodCollection:   db.Collection("custom_foods"),
+		redirectCollection:     db.Collection("food_redirects"),
 	}
 }
 
@@ -97,7 +210,13 @@ func (r *foodRepository) FindStandardByNames(ctx context.Context, names []string
 		return []*models.StandardFood{}, nil
 	}
 
-	cursor, err := r.standardFoodCollection.Find(ctx, bson.M{"name": bson.M{"$in": names}})
+	// 기본 이름뿐 아니라 번역된 이름으로도 찾음
+	or := bson.A{bson.M{"name": bson.M{"$in": names}}}
+	for _, locale := range models.TranslatedLocales {
+		or = append(or, bson.M{"translations." + locale + ".name": bson.M{"$in": names}})
+	}
+
+	cursor, err := r.standardFoodCollection.Find(ctx, bson.M{"$or": or})
 	if err != nil {
 		return nil, err
 	}
@@ -111,9 +230,206 @@ func (r *foodRepository) FindStandardByNames(ctx context.Context, names []string
 	return foods, nil
 }
 
-func (r *foodRepository) FindCustomByName(ctx context.Context, name string) (*models.CustomFood, error) {
+func (r *foodRepository) SearchStandards(ctx context.Context, query string, filter StandardFoodFilter, limit int) ([]models.StandardFood, error) {
+	pattern := primitive.Regex{Pattern: regexp.QuoteMeta(query), Options: "i"}
+	or := bson.A{bson.M{"name": pattern}}
+	for _, locale := range models.TranslatedLocales {
+		or = append(or, bson.M{"translations." + locale + ".name": pattern})
+	}
+
+	match := filter.toBSON()
+	match["$or"] = or
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "review_count", Value: -1}, {Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.standardFoodCollection.Find(ctx, match, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var foods []models.StandardFood
+	if err := cursor.All(ctx, &foods); err != nil {
+		return nil, err
+	}
+
+	return foods, nil
+}
+
+// 가진 재료가 하나 이상 들어가는 음식을 필요한 재료 대비 가진 재료 비율이 높은 순으로 조회
+func (r *foodRepository) FindStandardsByIngredients(ctx context.Context, ingredients []string, filter StandardFoodFilter, limit int) ([]models.StandardFood, error) {
+	match := filter.toBSON()
+	match["ingredients"] = bson.M{"$in": ingredients}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$addFields", Value: bson.M{
+			"coverage": bson.M{"$divide": bson.A{
+				bson.M{"$size": bson.M{"$setIntersection": bson.A{"$ingredients", ingredients}}},
+				bson.M{"$size": "$ingredients"},
+			}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "coverage", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: bson.M{"coverage": 0}}},
+	}
+
+	cursor, err := r.standardFoodCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var foods []models.StandardFood
+	if err := cursor.All(ctx, &foods); err != nil {
+		return nil, err
+	}
+
+	return foods, nil
+}
+
+// extraIDs에 포함된 음식은 모두 가져오고, 남은 자리는 base와 부모, 카테고리, 속성이 많이 겹치는 음식 순으로 채움 (base 자신은 제외)
+func (r *foodRepository) FindSimilarCandidates(ctx context.Context, base *models.StandardFood, extraIDs []primitive.ObjectID, filter StandardFoodFilter, limit int) ([]models.StandardFood, error) {
+	foods := []models.StandardFood{}
+	if len(extraIDs) > 0 {
+		match := filter.toBSON()
+		addIDCondition(match, "$in", extraIDs)
+		addIDCondition(match, "$ne", base.ID)
+
+		cursor, err := r.standardFoodCollection.Find(ctx, match, options.Find().SetLimit(int64(limit)))
+		if err != nil {
+			return nil, err
+		}
+		defer cursor.Close(ctx)
+
+		if err := cursor.All(ctx, &foods); err != nil {
+			return nil, err
+		}
+	}
+
+	attributeKeys := make([]string, 0, len(base.Attributes))
+	for key := range base.Attributes {
+		attributeKeys = append(attributeKeys, key)
+	}
+	sort.Strings(attributeKeys)
+
+	remaining := limit - len(foods)
+	or := bson.A{}
+	if len(base.Parents) > 0 {
+		or = append(or, bson.M{"parents": bson.M{"$in": base.Parents}})
+	}
+	if len(base.Categories) > 0 {
+		or = append(or, bson.M{"categories": bson.M{"$in": base.Categories}})
+	}
+	for _, key := range attributeKeys {
+		or = append(or, bson.M{"attributes." + key: base.Attributes[key]})
+	}
+	if remaining <= 0 || len(or) == 0 {
+		return foods, nil
+	}
+
+	match := filter.toBSON()
+	addIDCondition(match, "$ne", base.ID)
+	if len(extraIDs) > 0 {
+		// 위에서 이미 가져온 음식도 숨긴 음식과 함께 제외
+		addIDCondition(match, "$nin", append(append([]primitive.ObjectID{}, filter.ExcludeIDs...), extraIDs...))
+	}
+	match["$or"] = or
+
+	// 부모 3점, 카테고리 2점, 속성 0.5점으로 겹치는 정도를 매겨 상한을 적용하기 전에 정렬
+	overlap := func(field string, values []string, weight int) bson.M {
+		return bson.M{"$multiply": bson.A{weight, bson.M{"$size": bson.M{
+			"$setIntersection": bson.A{bson.M{"$ifNull": bson.A{"$" + field, bson.A{}}}, values},
+		}}}}
+	}
+	score := bson.A{
+		overlap("parents", nonNilStrings(base.Parents), 3),
+		overlap("categories", nonNilStrings(base.Categories), 2),
+	}
+	for _, key := range attributeKeys {
+		score = append(score, bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{"$attributes." + key, base.Attributes[key]}}, 0.5, 0,
+		}})
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$addFields", Value: bson.M{"tag_overlap": bson.M{"$add": score}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "tag_overlap", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: remaining}},
+		{{Key: "$project", Value: bson.M{"tag_overlap": 0}}},
+	}
+
+	cursor, err := r.standardFoodCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var tagged []models.StandardFood
+	if err := cursor.All(ctx, &tagged); err != nil {
+		return nil, err
+	}
+
+	return append(foods, tagged...), nil
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func (r *foodRepository) FindStandardsByIDsAndFilter(ctx context.Context, ids []primitive.ObjectID, filter StandardFoodFilter) ([]models.StandardFood, error) {
+	if len(ids) == 0 {
+		return []models.StandardFood{}, nil
+	}
+
+	match := filter.toBSON()
+	addIDCondition(match, "$in", ids)
+
+	cursor, err := r.standardFoodCollection.Find(ctx, match)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	foods := []models.StandardFood{}
+	if err := cursor.All(ctx, &foods); err != nil {
+		return nil, err
+	}
+
+	return foods, nil
+}
+
+func (r *foodRepository) FindCustomByNormalizedNames(ctx context.Context, normalizedNames []string) ([]*models.CustomFood, error) {
+	if len(normalizedNames) == 0 {
+		return []*models.CustomFood{}, nil
+	}
+
+	// 병합되어 사라진 커스텀 음식의 이름은 살아남은 음식의 별칭으로 남아 있음
+	cursor, err := r.customFoodCollection.Find(ctx, bson.M{"$or": bson.A{
+		bson.M{"normalized_name": bson.M{"$in": normalizedNames}},
+		bson.M{"aliases": bson.M{"$in": normalizedNames}},
+	}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var foods []*models.CustomFood
+	if err := cursor.All(ctx, &foods); err != nil {
+		return nil, err
+	}
+
+	return foods, nil
+}
+
+func (r *foodRepository) FindCustomByID(ctx context.Context, id primitive.ObjectID) (*models.CustomFood, error) {
 	var food models.CustomFood
-	err := r.customFoodCollection.FindOne(ctx, bson.M{"name": name}).Decode(&food)
+	err := r.customFoodCollection.FindOne(ctx, bson.M{"_id": id}).Decode(&food)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, nil
@@ -123,18 +439,19 @@ func (r *foodRepository) FindCustomByName(ctx context.Context, name string) (*mo
 	return &food, nil
 }
 
-func (r *foodRepository) FindCustomByNames(ctx context.Context, names []string) ([]*models.CustomFood, error) {
-	if len(names) == 0 {
-		return []*models.CustomFood{}, nil
-	}
+func (r *foodRepository) FindCustomsByReviewCount(ctx context.Context, skip int64, limit int64) ([]models.CustomFood, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "review_count", Value: -1}, {Key: "created_at", Value: 1}}).
+		SetSkip(skip).
+		SetLimit(limit)
 
-	cursor, err := r.customFoodCollection.Find(ctx, bson.M{"name": bson.M{"$in": names}})
+	cursor, err := r.customFoodCollection.Find(ctx, bson.M{}, opts)
 	if err != nil {
 		return nil, err
 	}
 	defer cursor.Close(ctx)
 
-	var foods []*models.CustomFood
+	foods := []models.CustomFood{}
 	if err := cursor.All(ctx, &foods); err != nil {
 		return nil, err
 	}
@@ -147,27 +464,97 @@ func (r *foodRepository) CreateStandards(ctx context.Context, foods []interface{
 	return err
 }
 
+func (r *foodRepository) CreateStandard(ctx context.Context, food *models.StandardFood) error {
+	_, err := r.standardFoodCollection.InsertOne(ctx, food)
+	return err
+}
+
+func (r *foodRepository) DeleteStandard(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.standardFoodCollection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
 func (r *foodRepository) CreateCustom(ctx context.Context, food models.CustomFood) error {
 	_, err := r.customFoodCollection.InsertOne(ctx, food)
 	return err
 }
 
-func (r *foodRepository) CreateCustoms(ctx context.Context, foods []interface{}) error {
-	_, err := r.customFoodCollection.InsertMany(ctx, foods)
+// normalized_name 기준으로 없을 때만 생성하며, 실패한 음식들의 normalized_name을 반환
+// 동시에 같은 이름을 upsert해 생긴 중복 키 에러는 이미 다른 요청이 만든 것이므로 실패로 보지 않음
+func (r *foodRepository) UpsertCustoms(ctx context.Context, foods []models.CustomFood) ([]string, error) {
+	if len(foods) == 0 {
+		return nil, nil
+	}
+
+	writeModels := make([]mongo.WriteModel, 0, len(foods))
+	for _, food := range foods {
+		writeModels = append(writeModels, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"normalized_name": food.NormalizedName}).
+			SetUpdate(bson.M{"$setOnInsert": bson.M{
+				"_id":          food.ID,
+				"name":         food.Name,
+				"review_count": food.ReviewCount,
+				"created_at":   food.CreatedAt,
+			}}).
+			SetUpsert(true))
+	}
+
+	_, err := r.customFoodCollection.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
+	if err == nil {
+		return nil, nil
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+		return nil, err
+	}
+
+	var failed []string
+	for _, writeErr := range bulkErr.WriteErrors {
+		if mongo.IsDuplicateKeyError(writeErr) {
+			continue
+		}
+		failed = append(failed, foods[writeErr.Index].NormalizedName)
+	}
+	return failed, nil
+}
+
+func (r *foodRepository) DeleteCustom(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.customFoodCollection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func (r *foodRepository) AddCustomAliases(ctx context.Context, id primitive.ObjectID, aliases []string) error {
+	_, err := r.customFoodCollection.UpdateOne(
+		ctx,
+		bson.M{"_id": id},
+		bson.M{"$addToSet": bson.M{"aliases": bson.M{"$each": aliases}}},
+	)
 	return err
 }
 
-func (r *foodRepository) GetRandomStandards(ctx context.Context, speed string, categories []string, count int) ([]models.StandardFood, error) {
-	matchQuery := bson.M{
-		"speed": bson.M{"$in": []string{speed, models.SpeedBoth}},
+// 아직 승격 대상이 정해지지 않았으면 standardID로 정하고, 이미 정해져 있으면 기존 ID를 반환
+func (r *foodRepository) ReserveCustomPromotion(ctx context.Context, id primitive.ObjectID, standardID primitive.ObjectID) (primitive.ObjectID, error) {
+	_, err := r.customFoodCollection.UpdateOne(
+		ctx,
+		bson.M{"_id": id, "promoted_to": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"promoted_to": standardID}},
+	)
+	if err != nil {
+		return primitive.NilObjectID, err
 	}
 
-	if len(categories) > 0 {
-		matchQuery["categories"] = bson.M{"$in": categories}
+	var food models.CustomFood
+	err = r.customFoodCollection.FindOne(ctx, bson.M{"_id": id}).Decode(&food)
+	if err != nil {
+		return primitive.NilObjectID, err
 	}
+	return food.PromotedTo, nil
+}
 
+func (r *foodRepository) GetRandomStandards(ctx context.Context, filter StandardFoodFilter, count int) ([]models.StandardFood, error) {
 	pipeline := mongo.Pipeline{
-		{{Key: "$match", Value: matchQuery}},
+		{{Key: "$match", Value: filter.toBSON()}},
 		{{Key: "$sample", Value: bson.M{"size": count}}},
 	}
 
@@ -185,6 +572,142 @@ func (r *foodRepository) GetRandomStandards(ctx context.Context, speed string, c
 	return foods, nil
 }
 
+func (r *foodRepository) CountStandards(ctx context.Context, filter StandardFoodFilter) (int64, error) {
+	return r.standardFoodCollection.CountDocuments(ctx, filter.toBSON())
+}
+
+// 필터에 맞는 음식을 _id 순으로 afterID 이후부터 limit개 가져오고, 각 facet 값별 음식 수를 함께 계산
+func (r *foodRepository) BrowseStandards(ctx context.Context, filter StandardFoodFilter, facetKeys []string, afterID primitive.ObjectID, limit int) ([]models.StandardFood, map[string]map[string]int, error) {
+	resultMatch := filter.attributesBSON("")
+	if !afterID.IsZero() {
+		resultMatch["_id"] = bson.M{"$gt": afterID}
+	}
+
+	facets := bson.M{
+		"foods": bson.A{
+			bson.M{"$match": resultMatch},
+			bson.M{"$sort": bson.M{"_id": 1}},
+			bson.M{"$limit": limit},
+		},
+	}
+	for _, key := range facetKeys {
+		facets[key] = bson.A{
+			bson.M{"$match": filter.attributesBSON(key)},
+			bson.M{"$match": bson.M{"attributes." + key: bson.M{"$exists": true}}},
+			bson.M{"$group": bson.M{"_id": "$attributes." + key, "count": bson.M{"$sum": 1}}},
+		}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter.withoutAttributes().baseBSON()}},
+		{{Key: "$facet", Value: facets}},
+	}
+
+	cursor, err := r.standardFoodCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cursor.Close(ctx)
+
+	foods := []models.StandardFood{}
+	facetCounts := make(map[string]map[string]int, len(facetKeys))
+	for _, key := range facetKeys {
+		facetCounts[key] = make(map[string]int)
+	}
+
+	if !cursor.Next(ctx) {
+		return foods, facetCounts, cursor.Err()
+	}
+
+	if err := cursor.Current.Lookup("foods").Unmarshal(&foods); err != nil {
+		return nil, nil, err
+	}
+	for _, key := range facetKeys {
+		var buckets []struct {
+			Value string `bson:"_id"`
+			Count int    `bson:"count"`
+		}
+		if err := cursor.Current.Lookup(key).Unmarshal(&buckets); err != nil {
+			return nil, nil, err
+		}
+		for _, bucket := range buckets {
+			facetCounts[key][bucket.Value] = bucket.Count
+		}
+	}
+
+	return foods, facetCounts, nil
+}
+
+// 리뷰가 하나 이상 있는 표준 음식의 누적 리뷰 통계
+func (r *foodRepository) FindStandardReviewStats(ctx context.Context) ([]models.FoodRatingStat, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "review_count": 1, "total_rating": 1})
+
+	cursor, err := r.standardFoodCollection.Find(ctx, bson.M{"review_count": bson.M{"$gt": 0}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var stats []models.FoodRatingStat
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// 좋아요가 하나 이상 있는 표준 음식의 누적 좋아요 수
+func (r *foodRepository) FindStandardLikeCounts(ctx context.Context) (map[primitive.ObjectID]int, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "like_count": 1})
+
+	cursor, err := r.standardFoodCollection.Find(ctx, bson.M{"like_count": bson.M{"$gt": 0}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		ID        primitive.ObjectID `bson:"_id"`
+		LikeCount int                `bson:"like_count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[primitive.ObjectID]int, len(results))
+	for _, r := range results {
+		counts[r.ID] = r.LikeCount
+	}
+	return counts, nil
+}
+
+func (r *foodRepository) CountStandardsByCategory(ctx context.Context) (map[string]int, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$unwind", Value: "$categories"}},
+		{{Key: "$group", Value: bson.M{"_id": "$categories", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cursor, err := r.standardFoodCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	counts := make(map[string]int)
+	for cursor.Next(ctx) {
+		var result struct {
+			Category string `bson:"_id"`
+			Count    int    `bson:"count"`
+		}
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+		counts[result.Category] = result.Count
+	}
+
+	return counts, nil
+}
+
 func (r *foodRepository) UpdateStandardCreatedReviewStats(ctx context.Context, foodIDs []primitive.ObjectID, rating int) error {
 	filter := bson.M{"_id": bson.M{"$in": foodIDs}}
 
@@ -240,6 +763,133 @@ func (r *foodRepository) UpdateCustomDeletedReviewStats(ctx context.Context, foo
 	return err
 }
 
+func (r *foodRepository) SetStandardReviewStats(ctx context.Context, foodID primitive.ObjectID, reviewCount, totalRating int) error {
+	_, err := r.standardFoodCollection.UpdateOne(
+		ctx,
+		bson.M{"_id": foodID},
+		bson.M{"$set": bson.M{"review_count": reviewCount, "total_rating": totalRating}},
+	)
+	return err
+}
+
+func (r *foodRepository) SetStandardLikeCount(ctx context.Context, foodID primitive.ObjectID, likeCount int) error {
+	_, err := r.standardFoodCollection.UpdateOne(
+		ctx,
+		bson.M{"_id": foodID},
+		bson.M{"$set": bson.M{"like_count": likeCount}},
+	)
+	return err
+}
+
+// nutrition이 nil이면 영양 정보를 제거
+func (r *foodRepository) UpdateStandardNutrition(ctx context.Context, foodID primitive.ObjectID, nutrition *models.Nutrition) error {
+	update := bson.M{"$unset": bson.M{"nutrition": ""}}
+	if nutrition != nil {
+		update = bson.M{"$set": bson.M{"nutrition": nutrition}}
+	}
+
+	_, err := r.standardFoodCollection.UpdateOne(ctx, bson.M{"_id": foodID}, update)
+	return err
+}
+
+// 요청에 있는 항목만 바꾸고, 빈 값은 필드를 지움. 음식이 없으면 (nil, nil)
+func (r *foodRepository) UpdateStandardDetails(ctx context.Context, foodID primitive.ObjectID, req models.UpdateStandardFoodRequest) (*models.StandardFood, error) {
+	set := bson.M{}
+	unset := bson.M{}
+	setOrUnset := func(field string, value interface{}, empty bool) {
+		if empty {
+			unset[field] = ""
+		} else {
+			set[field] = value
+		}
+	}
+
+	if req.ImageURL != nil {
+		set["image_url"] = *req.ImageURL
+	}
+	if req.Allergens != nil {
+		setOrUnset("allergens", *req.Allergens, len(*req.Allergens) == 0)
+		set["allergens_tagged"] = true
+	}
+	if req.DietTags != nil {
+		setOrUnset("diet_tags", *req.DietTags, len(*req.DietTags) == 0)
+	}
+	if req.Attributes != nil {
+		setOrUnset("attributes", *req.Attributes, len(*req.Attributes) == 0)
+	}
+	if req.Description != nil {
+		setOrUnset("description", *req.Description, *req.Description == "")
+	}
+	if req.Translations != nil {
+		setOrUnset("translations", *req.Translations, len(*req.Translations) == 0)
+	}
+	if req.Ingredients != nil {
+		setOrUnset("ingredients", *req.Ingredients, len(*req.Ingredients) == 0)
+	}
+
+	update := bson.M{}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	if len(update) == 0 {
+		return r.FindStandardByID(ctx, foodID)
+	}
+
+	var food models.StandardFood
+	err := r.standardFoodCollection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": foodID},
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&food)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &food, nil
+}
+
+func (r *foodRepository) SetCustomReviewCount(ctx context.Context, foodID primitive.ObjectID, reviewCount int) error {
+	_, err := r.customFoodCollection.UpdateOne(
+		ctx,
+		bson.M{"_id": foodID},
+		bson.M{"$set": bson.M{"review_count": reviewCount}},
+	)
+	return err
+}
+
+func (r *foodRepository) CreateRedirect(ctx context.Context, redirect models.FoodRedirect) error {
+	_, err := r.redirectCollection.InsertOne(ctx, redirect)
+	return err
+}
+
+func (r *foodRepository) FindRedirect(ctx context.Context, fromID primitive.ObjectID, foodType string) (*models.FoodRedirect, error) {
+	var redirect models.FoodRedirect
+	err := r.redirectCollection.FindOne(ctx, bson.M{"from_id": fromID, "type": foodType}).Decode(&redirect)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &redirect, nil
+}
+
+// 이미 oldToID를 가리키던 리다이렉트들이 한 번에 최종 음식으로 가도록 갱신
+func (r *foodRepository) RepointRedirects(ctx context.Context, oldToID primitive.ObjectID, newToID primitive.ObjectID, foodType string) error {
+	_, err := r.redirectCollection.UpdateMany(
+		ctx,
+		bson.M{"to_id": oldToID, "type": foodType},
+		bson.M{"$set": bson.M{"to_id": newToID}},
+	)
+	return err
+}
+
 func (r *foodRepository) IncrementLikeCount(ctx context.Context, foodID primitive.ObjectID) error {
 	result, err := r.standardFoodCollection.UpdateOne(
 		ctx,
@@ -0,0 +1,104 @@
+// api/repositories/hide.go
+
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type HideRepository interface {
+	Upsert(ctx context.Context, hide *models.Hide) error
+	FindActiveByUserID(ctx context.Context, userID primitive.ObjectID, now time.Time) ([]models.Hide, error)
+	FindActiveByUserIDs(ctx context.Context, userIDs []primitive.ObjectID, now time.Time) ([]models.Hide, error)
+	Delete(ctx context.Context, userID primitive.ObjectID, hideID primitive.ObjectID) (int64, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type hideRepository struct {
+	collection *mongo.Collection
+}
+
+func NewHideRepository(db *mongo.Database) HideRepository {
+	return &hideRepository{
+		collection: db.Collection("hides"),
+	}
+}
+
+// 같은 대상을 다시 숨기면 기간만 새로 덮어씀
+func (r *hideRepository) Upsert(ctx context.Context, hide *models.Hide) error {
+	filter := bson.M{"user_id": hide.UserID, "scope": hide.Scope}
+	if hide.Scope == models.HideScopeParent {
+		filter["parent"] = hide.Parent
+	} else {
+		filter["food_id"] = hide.FoodID
+	}
+
+	update := bson.M{
+		"$set":         bson.M{"created_at": hide.CreatedAt},
+		"$setOnInsert": bson.M{"_id": hide.ID},
+	}
+	if hide.ExpiresAt != nil {
+		update["$set"].(bson.M)["expires_at"] = hide.ExpiresAt
+	} else {
+		update["$unset"] = bson.M{"expires_at": ""}
+	}
+
+	var saved models.Hide
+	err := r.collection.FindOneAndUpdate(
+		ctx, filter, update,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&saved)
+	if err != nil {
+		return err
+	}
+	*hide = saved
+	return nil
+}
+
+// TTL 인덱스는 바로 지우지 않으므로 만료 시각도 직접 확인
+func (r *hideRepository) FindActiveByUserID(ctx context.Context, userID primitive.ObjectID, now time.Time) ([]models.Hide, error) {
+	return r.FindActiveByUserIDs(ctx, []primitive.ObjectID{userID}, now)
+}
+
+func (r *hideRepository) FindActiveByUserIDs(ctx context.Context, userIDs []primitive.ObjectID, now time.Time) ([]models.Hide, error) {
+	filter := bson.M{
+		"user_id": bson.M{"$in": userIDs},
+		"$or": []bson.M{
+			{"expires_at": bson.M{"$exists": false}},
+			{"expires_at": bson.M{"$gt": now}},
+		},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	hides := []models.Hide{}
+	if err := cursor.All(ctx, &hides); err != nil {
+		return nil, err
+	}
+	return hides, nil
+}
+
+func (r *hideRepository) Delete(ctx context.Context, userID primitive.ObjectID, hideID primitive.ObjectID) (int64, error) {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": hideID, "user_id": userID})
+	if err != nil {
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+func (r *hideRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID})
+	return err
+}
@@ -4,6 +4,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/seojoonrp/bapddang-server/models"
 	"go.mongodb.org/mongo-driver/bson"
@@ -18,6 +19,12 @@ type LikeRepository interface {
 	CheckLikedStatus(ctx context.Context, userID primitive.ObjectID, foodIDs []primitive.ObjectID) (map[primitive.ObjectID]bool, error)
 	FindLikesByUserID(ctx context.Context, userID primitive.ObjectID) ([]models.Like, error)
 	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+	CountByFoodID(ctx context.Context, foodID primitive.ObjectID) (int64, error)
+	ReassignFood(ctx context.Context, fromFoodID, toFoodID primitive.ObjectID) (int64, error)
+	CountByFoodSince(ctx context.Context, since time.Time) (map[primitive.ObjectID]int, error)
+	AggregateActivitySince(ctx context.Context, since time.Time, now time.Time, halfLife time.Duration) ([]models.FoodActivityStat, error)
+	FindAllUserFoodPairs(ctx context.Context) ([]models.Like, error)
+	FindRecentByUserID(ctx context.Context, userID primitive.ObjectID, limit int) ([]models.Like, error)
 }
 
 type likeRepository struct {
@@ -93,7 +100,123 @@ func (r *likeRepository) FindLikesByUserID(ctx context.Context, userID primitive
 	return likes, nil
 }
 
+// 전체 좋아요의 (사용자, 음식) 쌍만 가져옴
+func (r *likeRepository) FindAllUserFoodPairs(ctx context.Context) ([]models.Like, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1, "food_id": 1})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	likes := []models.Like{}
+	if err := cursor.All(ctx, &likes); err != nil {
+		return nil, err
+	}
+	return likes, nil
+}
+
+func (r *likeRepository) FindRecentByUserID(ctx context.Context, userID primitive.ObjectID, limit int) ([]models.Like, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	likes := []models.Like{}
+	if err := cursor.All(ctx, &likes); err != nil {
+		return nil, err
+	}
+	return likes, nil
+}
+
 func (r *likeRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
 	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID})
 	return err
 }
+
+func (r *likeRepository) CountByFoodID(ctx context.Context, foodID primitive.ObjectID) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{"food_id": foodID})
+}
+
+// fromFoodID의 좋아요를 toFoodID로 옮기며, 두 음식을 모두 좋아요한 유저의 중복 좋아요는 삭제
+func (r *likeRepository) ReassignFood(ctx context.Context, fromFoodID, toFoodID primitive.ObjectID) (int64, error) {
+	userIDs, err := r.collection.Distinct(ctx, "user_id", bson.M{"food_id": toFoodID})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(userIDs) > 0 {
+		_, err = r.collection.DeleteMany(ctx, bson.M{
+			"food_id": fromFoodID,
+			"user_id": bson.M{"$in": userIDs},
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	result, err := r.collection.UpdateMany(
+		ctx,
+		bson.M{"food_id": fromFoodID},
+		bson.M{"$set": bson.M{"food_id": toFoodID}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// since 이후 눌린 좋아요 수를 음식별로 집계
+func (r *likeRepository) CountByFoodSince(ctx context.Context, since time.Time) (map[primitive.ObjectID]int, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"created_at": bson.M{"$gte": since}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$food_id", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		FoodID primitive.ObjectID `bson:"_id"`
+		Count  int                `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[primitive.ObjectID]int, len(results))
+	for _, r := range results {
+		counts[r.FoodID] = r.Count
+	}
+	return counts, nil
+}
+
+// since 이후 활동을 음식별로 세고, 반감기 halfLife로 감쇠한 점수를 함께 계산
+func (r *likeRepository) AggregateActivitySince(ctx context.Context, since time.Time, now time.Time, halfLife time.Duration) ([]models.FoodActivityStat, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"created_at": bson.M{"$gte": since}}}},
+		decayedActivityGroupStage("$food_id", now, halfLife),
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var stats []models.FoodActivityStat
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,103 @@
+// api/repositories/meal_group.go
+
+package repositories
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MealGroupRepository interface {
+	Create(ctx context.Context, group *models.MealGroup) error
+	FindActiveByCode(ctx context.Context, code string, now time.Time) (*models.MealGroup, error)
+	AddMember(ctx context.Context, code string, userID primitive.ObjectID, now time.Time) (*models.MealGroup, error)
+	RemoveMember(ctx context.Context, userID primitive.ObjectID) error
+	ExistsActiveWithMembers(ctx context.Context, memberIDs []primitive.ObjectID, now time.Time) (bool, error)
+}
+
+type mealGroupRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMealGroupRepository(db *mongo.Database) MealGroupRepository {
+	return &mealGroupRepository{
+		collection: db.Collection("meal_groups"),
+	}
+}
+
+func (r *mealGroupRepository) Create(ctx context.Context, group *models.MealGroup) error {
+	_, err := r.collection.InsertOne(ctx, group)
+	return err
+}
+
+// TTL 인덱스는 바로 지우지 않으므로 만료 시각도 직접 확인
+func (r *mealGroupRepository) FindActiveByCode(ctx context.Context, code string, now time.Time) (*models.MealGroup, error) {
+	var group models.MealGroup
+	err := r.collection.FindOne(ctx, bson.M{"code": code, "expires_at": bson.M{"$gt": now}}).Decode(&group)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &group, nil
+}
+
+// 이미 멤버이면 그대로 두고, 인원이 가득 찼거나 만료됐으면 (nil, nil)
+func (r *mealGroupRepository) AddMember(ctx context.Context, code string, userID primitive.ObjectID, now time.Time) (*models.MealGroup, error) {
+	filter := bson.M{
+		"code":       code,
+		"expires_at": bson.M{"$gt": now},
+		"$or": bson.A{
+			bson.M{"member_ids": userID},
+			bson.M{memberSlotKey(models.MealGroupMaxMembers - 1): bson.M{"$exists": false}},
+		},
+	}
+
+	var group models.MealGroup
+	err := r.collection.FindOneAndUpdate(
+		ctx, filter,
+		bson.M{"$addToSet": bson.M{"member_ids": userID}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&group)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (r *mealGroupRepository) RemoveMember(ctx context.Context, userID primitive.ObjectID) error {
+	_, err := r.collection.UpdateMany(
+		ctx,
+		bson.M{"member_ids": userID},
+		bson.M{"$pull": bson.M{"member_ids": userID}},
+	)
+	return err
+}
+
+// memberIDs가 모두 들어 있는 만료되지 않은 모임이 있는지
+func (r *mealGroupRepository) ExistsActiveWithMembers(ctx context.Context, memberIDs []primitive.ObjectID, now time.Time) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{
+		"member_ids": bson.M{"$all": memberIDs},
+		"expires_at": bson.M{"$gt": now},
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// member_ids.N이 없으면 멤버가 N명 이하
+func memberSlotKey(index int) string {
+	return "member_ids." + strconv.Itoa(index)
+}
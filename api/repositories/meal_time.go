@@ -0,0 +1,75 @@
+// api/repositories/meal_time.go
+
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MealTimeRepository interface {
+	UpsertMany(ctx context.Context, suitabilities []models.MealTimeSuitability) error
+	DeleteComputedBefore(ctx context.Context, before time.Time) error
+	FindByTargets(ctx context.Context, scope string, targets []string) ([]models.MealTimeSuitability, error)
+}
+
+type mealTimeRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMealTimeRepository(db *mongo.Database) MealTimeRepository {
+	return &mealTimeRepository{
+		collection: db.Collection("meal_time_suitability"),
+	}
+}
+
+// 음식/부모마다 문서 하나를 유지하며 계산 결과로 덮어씀
+func (r *mealTimeRepository) UpsertMany(ctx context.Context, suitabilities []models.MealTimeSuitability) error {
+	if len(suitabilities) == 0 {
+		return nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(suitabilities))
+	for _, s := range suitabilities {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"scope": s.Scope, "target": s.Target}).
+			SetUpdate(bson.M{"$set": bson.M{
+				"weights":      s.Weights,
+				"review_count": s.ReviewCount,
+				"computed_at":  s.ComputedAt,
+			}}).
+			SetUpsert(true))
+	}
+
+	_, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// 이번 계산에서 빠진 음식/부모의 이전 결과를 정리
+func (r *mealTimeRepository) DeleteComputedBefore(ctx context.Context, before time.Time) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"computed_at": bson.M{"$lt": before}})
+	return err
+}
+
+func (r *mealTimeRepository) FindByTargets(ctx context.Context, scope string, targets []string) ([]models.MealTimeSuitability, error) {
+	if len(targets) == 0 {
+		return []models.MealTimeSuitability{}, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"scope": scope, "target": bson.M{"$in": targets}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	suitabilities := []models.MealTimeSuitability{}
+	if err := cursor.All(ctx, &suitabilities); err != nil {
+		return nil, err
+	}
+	return suitabilities, nil
+}
@@ -0,0 +1,73 @@
+// api/repositories/neighbor.go
+
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type NeighborRepository interface {
+	UpsertMany(ctx context.Context, neighbors []models.FoodNeighbors) error
+	DeleteComputedBefore(ctx context.Context, before time.Time) error
+	FindByFoodIDs(ctx context.Context, foodIDs []primitive.ObjectID) ([]models.FoodNeighbors, error)
+}
+
+type neighborRepository struct {
+	collection *mongo.Collection
+}
+
+func NewNeighborRepository(db *mongo.Database) NeighborRepository {
+	return &neighborRepository{
+		collection: db.Collection("food_neighbors"),
+	}
+}
+
+// 음식마다 문서 하나를 유지하며 계산 결과로 덮어씀
+func (r *neighborRepository) UpsertMany(ctx context.Context, neighbors []models.FoodNeighbors) error {
+	if len(neighbors) == 0 {
+		return nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(neighbors))
+	for _, n := range neighbors {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"food_id": n.FoodID}).
+			SetUpdate(bson.M{"$set": bson.M{"neighbors": n.Neighbors, "computed_at": n.ComputedAt}}).
+			SetUpsert(true))
+	}
+
+	_, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// 이번 계산에서 이웃이 없어진 음식의 이전 결과를 정리
+func (r *neighborRepository) DeleteComputedBefore(ctx context.Context, before time.Time) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"computed_at": bson.M{"$lt": before}})
+	return err
+}
+
+func (r *neighborRepository) FindByFoodIDs(ctx context.Context, foodIDs []primitive.ObjectID) ([]models.FoodNeighbors, error) {
+	if len(foodIDs) == 0 {
+		return []models.FoodNeighbors{}, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"food_id": bson.M{"$in": foodIDs}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var neighbors []models.FoodNeighbors
+	if err := cursor.All(ctx, &neighbors); err != nil {
+		return nil, err
+	}
+
+	return neighbors, nil
+}
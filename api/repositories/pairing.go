@@ -0,0 +1,73 @@
+// api/repositories/pairing.go
+
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PairingRepository interface {
+	UpsertMany(ctx context.Context, pairings []models.FoodPairing) error
+	DeleteComputedBefore(ctx context.Context, before time.Time) error
+	FindByFoodIDs(ctx context.Context, foodIDs []primitive.ObjectID) ([]models.FoodPairing, error)
+}
+
+type pairingRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPairingRepository(db *mongo.Database) PairingRepository {
+	return &pairingRepository{
+		collection: db.Collection("food_pairings"),
+	}
+}
+
+// 음식마다 문서 하나를 유지하며 계산 결과로 덮어씀
+func (r *pairingRepository) UpsertMany(ctx context.Context, pairings []models.FoodPairing) error {
+	if len(pairings) == 0 {
+		return nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(pairings))
+	for _, p := range pairings {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"food_id": p.FoodID}).
+			SetUpdate(bson.M{"$set": bson.M{"pairs": p.Pairs, "computed_at": p.ComputedAt}}).
+			SetUpsert(true))
+	}
+
+	_, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// 이번 계산에서 짝이 없어진 음식의 이전 결과를 정리
+func (r *pairingRepository) DeleteComputedBefore(ctx context.Context, before time.Time) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"computed_at": bson.M{"$lt": before}})
+	return err
+}
+
+func (r *pairingRepository) FindByFoodIDs(ctx context.Context, foodIDs []primitive.ObjectID) ([]models.FoodPairing, error) {
+	if len(foodIDs) == 0 {
+		return []models.FoodPairing{}, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"food_id": bson.M{"$in": foodIDs}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var pairings []models.FoodPairing
+	if err := cursor.All(ctx, &pairings); err != nil {
+		return nil, err
+	}
+
+	return pairings, nil
+}
@@ -0,0 +1,54 @@
+// api/repositories/ranking.go
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RankingRepository interface {
+	UpsertSnapshot(ctx context.Context, snapshot *models.RankingSnapshot) error
+	FindSnapshot(ctx context.Context, kind string, period string) (*models.RankingSnapshot, error)
+}
+
+type rankingRepository struct {
+	collection *mongo.Collection
+}
+
+func NewRankingRepository(db *mongo.Database) RankingRepository {
+	return &rankingRepository{
+		collection: db.Collection("ranking_snapshots"),
+	}
+}
+
+// (kind, period)마다 최신 스냅샷 하나만 유지
+func (r *rankingRepository) UpsertSnapshot(ctx context.Context, snapshot *models.RankingSnapshot) error {
+	filter := bson.M{"kind": snapshot.Kind, "period": snapshot.Period}
+	update := bson.M{
+		"$set": bson.M{
+			"entries":      snapshot.Entries,
+			"period_start": snapshot.PeriodStart,
+			"computed_at":  snapshot.ComputedAt,
+		},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *rankingRepository) FindSnapshot(ctx context.Context, kind string, period string) (*models.RankingSnapshot, error) {
+	var snapshot models.RankingSnapshot
+	err := r.collection.FindOne(ctx, bson.M{"kind": kind, "period": period}).Decode(&snapshot)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &snapshot, nil
+}
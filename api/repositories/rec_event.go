@@ -0,0 +1,146 @@
+// api/repositories/rec_event.go
+
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RecEventRepository interface {
+	Create(ctx context.Context, event *models.RecEvent) error
+	Delete(ctx context.Context, eventID primitive.ObjectID) error
+	IncrementImpressions(ctx context.Context, userID primitive.ObjectID, foodIDs []primitive.ObjectID, at time.Time) error
+	IncrementReaction(ctx context.Context, userID primitive.ObjectID, foodID primitive.ObjectID, eventType string, at time.Time) error
+	AggregateCTR(ctx context.Context, scope string, targetIDs []primitive.ObjectID, since time.Time, limit int) ([]models.CTRStat, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type recEventRepository struct {
+	events *mongo.Collection
+	stats  *mongo.Collection
+}
+
+func NewRecEventRepository(db *mongo.Database) RecEventRepository {
+	return &recEventRepository{
+		events: db.Collection("recommendation_events"),
+		stats:  db.Collection("recommendation_stats"),
+	}
+}
+
+var reactionFields = map[string]string{
+	models.RecEventClick:  "clicks",
+	models.RecEventLike:   "likes",
+	models.RecEventReview: "reviews",
+}
+
+func (r *recEventRepository) Create(ctx context.Context, event *models.RecEvent) error {
+	_, err := r.events.InsertOne(ctx, event)
+	return err
+}
+
+func (r *recEventRepository) Delete(ctx context.Context, eventID primitive.ObjectID) error {
+	_, err := r.events.DeleteOne(ctx, bson.M{"_id": eventID})
+	return err
+}
+
+func (r *recEventRepository) IncrementImpressions(ctx context.Context, userID primitive.ObjectID, foodIDs []primitive.ObjectID, at time.Time) error {
+	if len(foodIDs) == 0 {
+		return nil
+	}
+
+	day := statDay(at)
+	writes := make([]mongo.WriteModel, 0, len(foodIDs)+2)
+	for _, foodID := range foodIDs {
+		writes = append(writes, statIncrement(models.RecStatScopeFood, foodID, day, "impressions", 1))
+	}
+	writes = append(writes,
+		statIncrement(models.RecStatScopeUser, userID, day, "impressions", len(foodIDs)),
+		statIncrement(models.RecStatScopeGlobal, primitive.NilObjectID, day, "impressions", len(foodIDs)),
+	)
+
+	_, err := r.stats.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+func (r *recEventRepository) IncrementReaction(ctx context.Context, userID primitive.ObjectID, foodID primitive.ObjectID, eventType string, at time.Time) error {
+	field := reactionFields[eventType]
+	day := statDay(at)
+
+	writes := []mongo.WriteModel{
+		statIncrement(models.RecStatScopeFood, foodID, day, field, 1),
+		statIncrement(models.RecStatScopeUser, userID, day, field, 1),
+		statIncrement(models.RecStatScopeGlobal, primitive.NilObjectID, day, field, 1),
+	}
+
+	_, err := r.stats.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// targetIDs가 비어 있으면 scope 전체를 노출 수 순으로 limit개까지 집계
+func (r *recEventRepository) AggregateCTR(ctx context.Context, scope string, targetIDs []primitive.ObjectID, since time.Time, limit int) ([]models.CTRStat, error) {
+	match := bson.M{
+		"scope": scope,
+		"day":   bson.M{"$gte": statDay(since)},
+	}
+	if len(targetIDs) > 0 {
+		match["target_id"] = bson.M{"$in": targetIDs}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id":         "$target_id",
+			"impressions": bson.M{"$sum": "$impressions"},
+			"clicks":      bson.M{"$sum": "$clicks"},
+			"likes":       bson.M{"$sum": "$likes"},
+			"reviews":     bson.M{"$sum": "$reviews"},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "impressions", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+	if limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: limit}})
+	}
+
+	cursor, err := r.stats.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := []models.CTRStat{}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	for i := range results {
+		results[i].ComputeCTR()
+	}
+	return results, nil
+}
+
+// 음식별/전체 카운터는 익명 집계라서 남겨둠
+func (r *recEventRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	if _, err := r.events.DeleteMany(ctx, bson.M{"user_id": userID}); err != nil {
+		return err
+	}
+	_, err := r.stats.DeleteMany(ctx, bson.M{"scope": models.RecStatScopeUser, "target_id": userID})
+	return err
+}
+
+// 일별 카운터는 UTC 자정 기준
+func statDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+func statIncrement(scope string, targetID primitive.ObjectID, day time.Time, field string, n int) mongo.WriteModel {
+	return mongo.NewUpdateOneModel().
+		SetFilter(bson.M{"scope": scope, "target_id": targetID, "day": day}).
+		SetUpdate(bson.M{"$inc": bson.M{field: n}}).
+		SetUpsert(true)
+}
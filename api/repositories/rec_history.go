@@ -15,9 +15,12 @@ import (
 
 type RecHistoryRepository interface {
 	SaveHistory(ctx context.Context, history models.RecHistory) error
+	FindByID(ctx context.Context, historyID primitive.ObjectID) (*models.RecHistory, error)
 	GetRecentFoodIDsMap(ctx context.Context, userID primitive.ObjectID, days int) (map[primitive.ObjectID]time.Time, error)
+	GetRecentFoodIDsMapForUsers(ctx context.Context, userIDs []primitive.ObjectID, days int) (map[primitive.ObjectID]time.Time, error)
 	GetLatestParents(ctx context.Context, userID primitive.ObjectID, days int) ([]string, error)
 	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+	ReplaceFoodID(ctx context.Context, oldFoodID, newFoodID primitive.ObjectID) error
 }
 
 type recHistoryRepo struct {
@@ -35,10 +38,27 @@ func (r *recHistoryRepo) SaveHistory(ctx context.Context, history models.RecHist
 	return err
 }
 
+func (r *recHistoryRepo) FindByID(ctx context.Context, historyID primitive.ObjectID) (*models.RecHistory, error) {
+	var history models.RecHistory
+	err := r.collection.FindOne(ctx, bson.M{"_id": historyID}).Decode(&history)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &history, nil
+}
+
 func (r *recHistoryRepo) GetRecentFoodIDsMap(ctx context.Context, userID primitive.ObjectID, days int) (map[primitive.ObjectID]time.Time, error) {
+	return r.GetRecentFoodIDsMapForUsers(ctx, []primitive.ObjectID{userID}, days)
+}
+
+// 여러 사용자 중 누구에게든 추천된 음식과 마지막으로 추천된 시각
+func (r *recHistoryRepo) GetRecentFoodIDsMapForUsers(ctx context.Context, userIDs []primitive.ObjectID, days int) (map[primitive.ObjectID]time.Time, error) {
 	threshold := time.Now().AddDate(0, 0, -days)
 	filter := bson.M{
-		"user_id":    userID,
+		"user_id":    bson.M{"$in": userIDs},
 		"created_at": bson.M{"$gte": threshold},
 	}
 
@@ -90,3 +110,17 @@ func (r *recHistoryRepo) DeleteByUserID(ctx context.Context, userID primitive.Ob
 	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID})
 	return err
 }
+
+func (r *recHistoryRepo) ReplaceFoodID(ctx context.Context, oldFoodID, newFoodID primitive.ObjectID) error {
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{"id": oldFoodID}},
+	})
+
+	_, err := r.collection.UpdateMany(
+		ctx,
+		bson.M{"food_ids": oldFoodID},
+		bson.M{"$set": bson.M{"food_ids.$[id]": newFoodID}},
+		opts,
+	)
+	return err
+}
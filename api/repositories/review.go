@@ -4,6 +4,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/seojoonrp/bapddang-server/models"
 	"go.mongodb.org/mongo-driver/bson"
@@ -17,10 +18,25 @@ type ReviewRepository interface {
 	Update(ctx context.Context, review *models.Review) error
 	Delete(ctx context.Context, reviewID primitive.ObjectID) error
 	FindByUserIDAndDay(ctx context.Context, userID primitive.ObjectID, day int) ([]models.Review, error)
+	FindByUserIDAndDayRange(ctx context.Context, userID primitive.ObjectID, fromDay int, toDay int) ([]models.Review, error)
 	FindByID(ctx context.Context, reviewID primitive.ObjectID) (*models.Review, error)
 	FindAllByUserID(ctx context.Context, userID primitive.ObjectID) ([]models.Review, error)
 	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
 	FindRecentWithStandardFood(ctx context.Context, userID primitive.ObjectID, limit int64) ([]models.Review, error)
+	ReplaceFoodItem(ctx context.Context, oldFoodID string, oldType string, newItem models.ReviewFoodItem) (int64, error)
+	AggregateFoodItemStats(ctx context.Context, foodID string, foodType string) (int, int, error)
+	PullFoodItemWhereBoth(ctx context.Context, removeFoodID string, keepFoodID string, foodType string) (int64, error)
+	AggregateFoodReviewStats(ctx context.Context, foodID string, foodType string) (*models.FoodReviewStats, error)
+	FindSnippetsByFoodItem(ctx context.Context, foodID string, foodType string, beforeID primitive.ObjectID, limit int) ([]models.ReviewSnippet, error)
+	AggregateStandardRatingsSince(ctx context.Context, since time.Time) ([]models.FoodRatingStat, error)
+	AggregateCoOccurringStandards(ctx context.Context, foodID string, limit int) ([]models.FoodCoOccurrence, error)
+	FindStandardBasketsSince(ctx context.Context, since time.Time) ([][]string, error)
+	AggregateStandardActivitySince(ctx context.Context, since time.Time, now time.Time, halfLife time.Duration) ([]models.FoodActivityStat, error)
+	ExistsStandardByUserBetween(ctx context.Context, userID primitive.ObjectID, foodID string, from time.Time, to time.Time) (bool, error)
+	AggregateUserStandardRatings(ctx context.Context, minRating int) ([]models.UserFoodRating, error)
+	FindByUserIDSince(ctx context.Context, userID primitive.ObjectID, since time.Time, limit int) ([]models.Review, error)
+	FindByUserIDsSince(ctx context.Context, userIDs []primitive.ObjectID, since time.Time, limit int) ([]models.Review, error)
+	AggregateStandardMealTimes(ctx context.Context) ([]models.FoodMealTimeCount, error)
 }
 
 type reviewRepository struct {
@@ -74,6 +90,25 @@ func (r *reviewRepository) FindByUserIDAndDay(ctx context.Context, userID primit
 	return reviews, nil
 }
 
+// fromDay와 toDay를 모두 포함. 지난 날짜로 작성한 리뷰는 week가 작성 시점의 주로 저장되므로 day로 조회
+func (r *reviewRepository) FindByUserIDAndDayRange(ctx context.Context, userID primitive.ObjectID, fromDay int, toDay int) ([]models.Review, error) {
+	reviews := []models.Review{}
+
+	filter := bson.M{"user_id": userID, "day": bson.M{"$gte": fromDay, "$lte": toDay}}
+	opts := options.Find().SetSort(bson.D{{Key: "day", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	if err = cursor.All(ctx, &reviews); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
 func (r *reviewRepository) FindByID(ctx context.Context, reviewID primitive.ObjectID) (*models.Review, error) {
 	var review models.Review
 	err := r.collection.FindOne(ctx, bson.M{"_id": reviewID}).Decode(&review)
@@ -103,11 +138,55 @@ func (r *reviewRepository) FindAllByUserID(ctx context.Context, userID primitive
 	return reviews, nil
 }
 
+// 최근 리뷰부터 limit개
+func (r *reviewRepository) FindByUserIDSince(ctx context.Context, userID primitive.ObjectID, since time.Time, limit int) ([]models.Review, error) {
+	return r.FindByUserIDsSince(ctx, []primitive.ObjectID{userID}, since, limit)
+}
+
+func (r *reviewRepository) FindByUserIDsSince(ctx context.Context, userIDs []primitive.ObjectID, since time.Time, limit int) ([]models.Review, error) {
+	filter := bson.M{
+		"user_id":    bson.M{"$in": userIDs},
+		"created_at": bson.M{"$gte": since},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	reviews := []models.Review{}
+	if err := cursor.All(ctx, &reviews); err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
 func (r *reviewRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
 	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID})
 	return err
 }
 
+// 사용자가 [from, to) 사이에 foodID 표준 음식이 담긴 리뷰를 남겼는지 확인
+func (r *reviewRepository) ExistsStandardByUserBetween(ctx context.Context, userID primitive.ObjectID, foodID string, from time.Time, to time.Time) (bool, error) {
+	filter := bson.M{
+		"user_id":    userID,
+		"created_at": bson.M{"$gte": from, "$lt": to},
+		"foods": bson.M{
+			"$elemMatch": bson.M{"food_id": foodID, "type": models.FoodTypeStandard},
+		},
+	}
+
+	count, err := r.collection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
 func (r *reviewRepository) FindRecentWithStandardFood(ctx context.Context, userID primitive.ObjectID, limit int64) ([]models.Review, error) {
 	var reviews []models.Review
 
@@ -134,3 +213,419 @@ func (r *reviewRepository) FindRecentWithStandardFood(ctx context.Context, userI
 
 	return reviews, nil
 }
+
+// 리뷰들의 foods 배열에서 (oldFoodID, oldType) 항목을 newItem으로 교체
+func (r *reviewRepository) ReplaceFoodItem(ctx context.Context, oldFoodID string, oldType string, newItem models.ReviewFoodItem) (int64, error) {
+	filter := bson.M{
+		"foods": bson.M{
+			"$elemMatch": bson.M{"food_id": oldFoodID, "type": oldType},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"foods.$[item].food_id":   newItem.FoodID,
+			"foods.$[item].food_name": newItem.FoodName,
+			"foods.$[item].type":      newItem.Type,
+		},
+	}
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{"item.food_id": oldFoodID, "item.type": oldType}},
+	})
+
+	result, err := r.collection.UpdateMany(ctx, filter, update, opts)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// 해당 음식이 포함된 리뷰 수와 평점 합계를 반환
+func (r *reviewRepository) AggregateFoodItemStats(ctx context.Context, foodID string, foodType string) (int, int, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"foods": bson.M{
+				"$elemMatch": bson.M{"food_id": foodID, "type": foodType},
+			},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":          nil,
+			"review_count": bson.M{"$sum": 1},
+			"total_rating": bson.M{"$sum": "$rating"},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var result struct {
+		ReviewCount int `bson:"review_count"`
+		TotalRating int `bson:"total_rating"`
+	}
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&result); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return result.ReviewCount, result.TotalRating, cursor.Err()
+}
+
+// removeFoodID와 keepFoodID가 모두 포함된 리뷰에서 removeFoodID 항목만 제거
+func (r *reviewRepository) PullFoodItemWhereBoth(ctx context.Context, removeFoodID string, keepFoodID string, foodType string) (int64, error) {
+	filter := bson.M{
+		"$and": bson.A{
+			bson.M{"foods": bson.M{"$elemMatch": bson.M{"food_id": removeFoodID, "type": foodType}}},
+			bson.M{"foods": bson.M{"$elemMatch": bson.M{"food_id": keepFoodID, "type": foodType}}},
+		},
+	}
+	update := bson.M{
+		"$pull": bson.M{"foods": bson.M{"food_id": removeFoodID, "type": foodType}},
+	}
+
+	result, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// 해당 음식이 포함된 리뷰의 평점 분포와 식사 시간대별 리뷰 수
+func (r *reviewRepository) AggregateFoodReviewStats(ctx context.Context, foodID string, foodType string) (*models.FoodReviewStats, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"foods": bson.M{
+				"$elemMatch": bson.M{"food_id": foodID, "type": foodType},
+			},
+		}}},
+		{{Key: "$facet", Value: bson.M{
+			"ratings": bson.A{
+				bson.M{"$group": bson.M{"_id": "$rating", "count": bson.M{"$sum": 1}}},
+			},
+			"meal_times": bson.A{
+				bson.M{"$group": bson.M{"_id": "$meal_time", "count": bson.M{"$sum": 1}}},
+			},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result struct {
+		Ratings []struct {
+			Rating int `bson:"_id"`
+			Count  int `bson:"count"`
+		} `bson:"ratings"`
+		MealTimes []struct {
+			MealTime string `bson:"_id"`
+			Count    int    `bson:"count"`
+		} `bson:"meal_times"`
+	}
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	stats := &models.FoodReviewStats{
+		RatingHistogram:   map[int]int{1: 0, 2: 0, 3: 0, 4: 0, 5: 0},
+		MealTimeBreakdown: make(map[string]int),
+	}
+	for _, r := range result.Ratings {
+		stats.RatingHistogram[r.Rating] += r.Count
+		stats.ReviewCount += r.Count
+		stats.TotalRating += r.Rating * r.Count
+	}
+	for _, m := range result.MealTimes {
+		stats.MealTimeBreakdown[m.MealTime] = m.Count
+	}
+
+	return stats, nil
+}
+
+// 코멘트가 있는 리뷰만 최신순으로, beforeID가 있으면 그보다 오래된 것부터 조회
+func (r *reviewRepository) FindSnippetsByFoodItem(ctx context.Context, foodID string, foodType string, beforeID primitive.ObjectID, limit int) ([]models.ReviewSnippet, error) {
+	filter := bson.M{
+		"foods": bson.M{
+			"$elemMatch": bson.M{"food_id": foodID, "type": foodType},
+		},
+		"comment": bson.M{"$ne": ""},
+	}
+	if !beforeID.IsZero() {
+		filter["_id"] = bson.M{"$lt": beforeID}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(limit)).
+		SetProjection(bson.M{"comment": 1, "rating": 1, "meal_time": 1, "image_url": 1, "created_at": 1})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	snippets := []models.ReviewSnippet{}
+	if err := cursor.All(ctx, &snippets); err != nil {
+		return nil, err
+	}
+
+	return snippets, nil
+}
+
+// 클라이언트가 보낸 food_id 문자열을 ObjectID로 변환하고, 형식이 잘못된 값은 null로 둠
+func standardFoodObjectID() bson.M {
+	return bson.M{"$convert": bson.M{
+		"input":   "$foods.food_id",
+		"to":      "objectId",
+		"onError": nil,
+		"onNull":  nil,
+	}}
+}
+
+// id별로 문서 수와, 작성 시각에서 now까지 반감기마다 절반이 되는 가중치 합(0.5 ^ (경과 시간 / 반감기))을 구하는 $group 단계
+func decayedActivityGroupStage(id interface{}, now time.Time, halfLife time.Duration) bson.D {
+	return bson.D{{Key: "$group", Value: bson.M{
+		"_id":   id,
+		"count": bson.M{"$sum": 1},
+		"decayed_score": bson.M{"$sum": bson.M{
+			"$pow": bson.A{0.5, bson.M{"$divide": bson.A{
+				bson.M{"$subtract": bson.A{now, "$created_at"}},
+				float64(halfLife.Milliseconds()),
+			}}},
+		}},
+	}}}
+}
+
+// since 이후 작성된 리뷰에서 표준 음식별 리뷰 수와 평점 합계를 집계
+func (r *reviewRepository) AggregateStandardRatingsSince(ctx context.Context, since time.Time) ([]models.FoodRatingStat, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"created_at": bson.M{"$gte": since},
+			"foods.type": models.FoodTypeStandard,
+		}}},
+		{{Key: "$unwind", Value: "$foods"}},
+		{{Key: "$match", Value: bson.M{
+			"foods.type":    models.FoodTypeStandard,
+			"foods.food_id": bson.M{"$ne": ""},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":          standardFoodObjectID(),
+			"review_count": bson.M{"$sum": 1},
+			"total_rating": bson.M{"$sum": "$rating"},
+		}}},
+		{{Key: "$match", Value: bson.M{"_id": bson.M{"$ne": nil}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var stats []models.FoodRatingStat
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// since 이후 활동을 음식별로 세고, 반감기 halfLife로 감쇠한 점수를 함께 계산
+func (r *reviewRepository) AggregateStandardActivitySince(ctx context.Context, since time.Time, now time.Time, halfLife time.Duration) ([]models.FoodActivityStat, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"created_at": bson.M{"$gte": since},
+			"foods.type": models.FoodTypeStandard,
+		}}},
+		{{Key: "$unwind", Value: "$foods"}},
+		{{Key: "$match", Value: bson.M{
+			"foods.type":    models.FoodTypeStandard,
+			"foods.food_id": bson.M{"$ne": ""},
+		}}},
+		decayedActivityGroupStage(standardFoodObjectID(), now, halfLife),
+		{{Key: "$match", Value: bson.M{"_id": bson.M{"$ne": nil}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var stats []models.FoodActivityStat
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// foodID와 같은 리뷰에 함께 기록된 표준 음식들을 함께 등장한 횟수 순으로 반환
+func (r *reviewRepository) AggregateCoOccurringStandards(ctx context.Context, foodID string, limit int) ([]models.FoodCoOccurrence, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"foods": bson.M{
+				"$elemMatch": bson.M{"food_id": foodID, "type": models.FoodTypeStandard},
+			},
+		}}},
+		{{Key: "$unwind", Value: "$foods"}},
+		{{Key: "$match", Value: bson.M{
+			"foods.type":    models.FoodTypeStandard,
+			"foods.food_id": bson.M{"$nin": bson.A{foodID, ""}},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   standardFoodObjectID(),
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"_id": bson.M{"$ne": nil}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.FoodCoOccurrence
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// 사용자가 minRating 이상으로 평가한 표준 음식과 그 중 가장 높은 평점
+func (r *reviewRepository) AggregateUserStandardRatings(ctx context.Context, minRating int) ([]models.UserFoodRating, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"rating":     bson.M{"$gte": minRating},
+			"foods.type": models.FoodTypeStandard,
+		}}},
+		{{Key: "$unwind", Value: "$foods"}},
+		{{Key: "$match", Value: bson.M{
+			"foods.type":    models.FoodTypeStandard,
+			"foods.food_id": bson.M{"$ne": ""},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"user_id": "$user_id",
+				"food_id": standardFoodObjectID(),
+			},
+			"rating": bson.M{"$max": "$rating"},
+		}}},
+		{{Key: "$match", Value: bson.M{"_id.food_id": bson.M{"$ne": nil}}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":     0,
+			"user_id": "$_id.user_id",
+			"food_id": "$_id.food_id",
+			"rating":  1,
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := []models.UserFoodRating{}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// 표준 음식별로 리뷰의 meal_time 값마다 개수를 셈 (표기는 서비스에서 정규화)
+func (r *reviewRepository) AggregateStandardMealTimes(ctx context.Context) ([]models.FoodMealTimeCount, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"foods.type": models.FoodTypeStandard}}},
+		{{Key: "$unwind", Value: "$foods"}},
+		{{Key: "$match", Value: bson.M{
+			"foods.type":    models.FoodTypeStandard,
+			"foods.food_id": bson.M{"$ne": ""},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"food_id":   "$foods.food_id",
+				"meal_time": "$meal_time",
+			},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":       0,
+			"food_id":   "$_id.food_id",
+			"meal_time": "$_id.meal_time",
+			"count":     1,
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := []models.FoodMealTimeCount{}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// since 이후 작성된 리뷰마다 담긴 표준 음식 ID 목록 (표준 음식이 하나 이상인 리뷰만)
+func (r *reviewRepository) FindStandardBasketsSince(ctx context.Context, since time.Time) ([][]string, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"created_at": bson.M{"$gte": since},
+			"foods.type": models.FoodTypeStandard,
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id": 0,
+			"food_ids": bson.M{"$map": bson.M{
+				"input": bson.M{"$filter": bson.M{
+					"input": "$foods",
+					"as":    "item",
+					"cond": bson.M{"$and": bson.A{
+						bson.M{"$eq": bson.A{"$$item.type", models.FoodTypeStandard}},
+						bson.M{"$ne": bson.A{"$$item.food_id", ""}},
+					}},
+				}},
+				"as": "item",
+				"in": "$$item.food_id",
+			}},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	baskets := [][]string{}
+	for cursor.Next(ctx) {
+		var doc struct {
+			FoodIDs []string `bson:"food_ids"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		if len(doc.FoodIDs) > 0 {
+			baskets = append(baskets, doc.FoodIDs)
+		}
+	}
+
+	return baskets, cursor.Err()
+}
@@ -0,0 +1,81 @@
+// api/repositories/taste.go
+
+package repositories
+
+import (
+	"context"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TasteRepository interface {
+	Upsert(ctx context.Context, profile models.TasteProfile) error
+	FindByUserID(ctx context.Context, userID primitive.ObjectID) (*models.TasteProfile, error)
+	FindByUserIDs(ctx context.Context, userIDs []primitive.ObjectID) ([]models.TasteProfile, error)
+	DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error
+}
+
+type tasteRepository struct {
+	collection *mongo.Collection
+}
+
+func NewTasteRepository(db *mongo.Database) TasteRepository {
+	return &tasteRepository{
+		collection: db.Collection("taste_profiles"),
+	}
+}
+
+// 사용자마다 문서 하나를 유지하며 계산 결과로 덮어씀
+func (r *tasteRepository) Upsert(ctx context.Context, profile models.TasteProfile) error {
+	_, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"user_id": profile.UserID},
+		bson.M{"$set": bson.M{
+			"categories":   profile.Categories,
+			"parents":      profile.Parents,
+			"signal_count": profile.SignalCount,
+			"updated_at":   profile.UpdatedAt,
+		}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *tasteRepository) FindByUserID(ctx context.Context, userID primitive.ObjectID) (*models.TasteProfile, error) {
+	var profile models.TasteProfile
+	err := r.collection.FindOne(ctx, bson.M{"user_id": userID}).Decode(&profile)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *tasteRepository) FindByUserIDs(ctx context.Context, userIDs []primitive.ObjectID) ([]models.TasteProfile, error) {
+	if len(userIDs) == 0 {
+		return []models.TasteProfile{}, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": bson.M{"$in": userIDs}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	profiles := []models.TasteProfile{}
+	if err := cursor.All(ctx, &profiles); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func (r *tasteRepository) DeleteByUserID(ctx context.Context, userID primitive.ObjectID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"user_id": userID})
+	return err
+}
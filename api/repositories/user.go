@@ -14,6 +14,7 @@ import (
 
 type UserRepository interface {
 	FindByID(ctx context.Context, userID primitive.ObjectID) (*models.User, error)
+	FindByIDs(ctx context.Context, userIDs []primitive.ObjectID) ([]*models.User, error)
 	FindByUsername(ctx context.Context, username string) (*models.User, error)
 	Create(ctx context.Context, user *models.User) error
 	Delete(ctx context.Context, userID primitive.ObjectID) error
@@ -21,6 +22,8 @@ type UserRepository interface {
 	UpdateDayAndWeek(ctx context.Context, userID primitive.ObjectID, newDay int, newWeek int) error
 	UpdateAgreement(ctx context.Context, userID primitive.ObjectID, isAgreed bool, agreedAt time.Time) error
 	UpdatePassword(ctx context.Context, userID primitive.ObjectID, newPassword string) error
+	UpdateDietaryProfile(ctx context.Context, userID primitive.ObjectID, profile models.DietaryProfile) error
+	UpdateLocale(ctx context.Context, userID primitive.ObjectID, locale string) error
 }
 
 type userRepository struct {
@@ -43,6 +46,24 @@ func (r *userRepository) FindByID(ctx context.Context, userID primitive.ObjectID
 	return &user, nil
 }
 
+func (r *userRepository) FindByIDs(ctx context.Context, userIDs []primitive.ObjectID) ([]*models.User, error) {
+	if len(userIDs) == 0 {
+		return []*models.User{}, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": userIDs}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	users := []*models.User{}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
 func (r *userRepository) FindByUsername(ctx context.Context, username string) (*models.User, error) {
 	var user models.User
 	err := r.collection.FindOne(ctx, bson.M{"username": username}).Decode(&user)
@@ -96,3 +117,22 @@ func (r *userRepository) UpdatePassword(ctx context.Context, userID primitive.Ob
 	_, err := r.collection.UpdateOne(ctx, filter, update)
 	return err
 }
+
+func (r *userRepository) UpdateDietaryProfile(ctx context.Context, userID primitive.ObjectID, profile models.DietaryProfile) error {
+	filter := bson.M{"_id": userID}
+	update := bson.M{"$set": bson.M{"dietary_profile": profile}}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func (r *userRepository) UpdateLocale(ctx context.Context, userID primitive.ObjectID, locale string) error {
+	filter := bson.M{"_id": userID}
+	update := bson.M{"$set": bson.M{"locale": locale}}
+	if locale == "" {
+		update = bson.M{"$unset": bson.M{"locale": ""}}
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
@@ -25,6 +25,16 @@ func SetupRoutes(
 	reviewHandler *handlers.ReviewHandler,
 	likeHandler *handlers.LikeHandler,
 	marshmallowHandler *handlers.MarshmallowHandler,
+	categoryHandler *handlers.CategoryHandler,
+	catalogHandler *handlers.CatalogHandler,
+	nutritionHandler *handlers.NutritionHandler,
+	rankingHandler *handlers.RankingHandler,
+	pairingHandler *handlers.PairingHandler,
+	uploadHandler *handlers.UploadHandler,
+	experimentHandler *handlers.ExperimentHandler,
+	recommendationHandler *handlers.RecommendationHandler,
+	hideHandler *handlers.HideHandler,
+	mealGroupHandler *handlers.MealGroupHandler,
 ) {
 	if config.AppConfig.AppEnv != "production" {
 		router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -55,8 +65,14 @@ func SetupRoutes(
 			users.GET("/me", userHandler.GetMe)
 			users.PATCH("/me/agreement", userHandler.AgreeTerms)
 			users.PATCH("/me/password", userHandler.ChangePassword)
+			users.PUT("/me/dietary-profile", userHandler.UpdateDietaryProfile)
+			users.PATCH("/me/locale", userHandler.UpdateLocale)
 			users.GET("/me/liked-foods", likeHandler.GetLikedFoods)
+			users.GET("/me/hides", hideHandler.GetHides)
+			users.DELETE("/me/hides/:hideID", hideHandler.Unhide)
 			users.GET("/me/reviews", reviewHandler.GetMyReviewsByDay)
+			users.GET("/me/nutrition", nutritionHandler.GetDailySummary)
+			users.GET("/me/nutrition/weekly", nutritionHandler.GetWeeklySummary)
 			users.PATCH("/me/sync", userHandler.SyncUserDayAndWeek)
 			users.DELETE("/me", userHandler.DeleteUser)
 		}
@@ -70,14 +86,33 @@ func SetupRoutes(
 			{
 				protectedFoods.POST("/:foodID/likes", likeHandler.LikeFood)
 				protectedFoods.DELETE("/:foodID/likes", likeHandler.UnlikeFood)
+				protectedFoods.POST("/:foodID/hides", hideHandler.HideFood)
 
 				protectedFoods.GET("/main-feed", foodHandler.GetMainFeedFoods)
+				protectedFoods.GET("/group-feed", foodHandler.GetGroupFeedFoods)
 				protectedFoods.GET("/category", foodHandler.GetFoodsByCategories)
+				protectedFoods.GET("/browse", foodHandler.BrowseFoods)
+				protectedFoods.GET("/search", foodHandler.SearchFoods)
+				protectedFoods.GET("/rankings", rankingHandler.GetRankings)
+				protectedFoods.GET("/trending", rankingHandler.GetTrending)
+				protectedFoods.GET("/:foodID/detail", foodHandler.GetFoodDetail)
+				protectedFoods.GET("/:foodID/reviews", foodHandler.GetFoodReviews)
+				protectedFoods.GET("/:foodID/similar", foodHandler.GetSimilarFoods)
+				protectedFoods.GET("/:foodID/pairings", pairingHandler.GetPairings)
 
 				protectedFoods.POST("/resolve", foodHandler.ResolveFoodItems)
+				protectedFoods.POST("/fridge", foodHandler.GetFridgeFoods)
 			}
 		}
 
+		groups := apiV1.Group("/groups")
+		groups.Use(middleware.AuthMiddleware())
+		{
+			groups.POST("", mealGroupHandler.CreateGroup)
+			groups.GET("/:code", mealGroupHandler.GetGroup)
+			groups.POST("/:code/members", mealGroupHandler.JoinGroup)
+		}
+
 		reviews := apiV1.Group("/reviews")
 		reviews.Use(middleware.AuthMiddleware())
 		{
@@ -86,6 +121,7 @@ func SetupRoutes(
 			reviews.DELETE("/:reviewID", reviewHandler.Delete)
 
 			reviews.GET("/recent", reviewHandler.GetRecentWithStandardFood)
+			reviews.GET("/suggestions", pairingHandler.SuggestForComposer)
 		}
 
 		marshmallows := apiV1.Group("/marshmallows")
@@ -94,9 +130,42 @@ func SetupRoutes(
 			marshmallows.GET("", marshmallowHandler.GetUserMarshmallows)
 		}
 
-		// adminRoutes := apiV1.Group("/admin") // no protection for now
-		// {
-		// 	adminRoutes.POST("/standard-foods", foodHandler.CreateStandardFoods)
-		// }
+		uploads := apiV1.Group("/uploads", middleware.RateLimitByIP(rate.Every(time.Second), 5))
+		uploads.Use(middleware.AuthMiddleware())
+		{
+			uploads.POST("/images", uploadHandler.UploadReviewImage)
+		}
+
+		recommendations := apiV1.Group("/recommendations")
+		recommendations.Use(middleware.AuthMiddleware())
+		{
+			recommendations.POST("/events", recommendationHandler.RecordEvent)
+		}
+
+		categories := apiV1.Group("/categories")
+		{
+			categories.GET("", categoryHandler.GetCategoryTree)
+		}
+
+		adminRoutes := apiV1.Group("/admin")
+		adminRoutes.Use(middleware.AdminMiddleware())
+		{
+			adminRoutes.POST("/standard-foods", foodHandler.CreateStandardFoods)
+			adminRoutes.PATCH("/standard-foods/:foodID", foodHandler.UpdateStandardDetails)
+			adminRoutes.PUT("/standard-foods/:foodID/nutrition", foodHandler.UpdateNutrition)
+			adminRoutes.POST("/categories", categoryHandler.CreateCategories)
+
+			adminRoutes.GET("/custom-foods", catalogHandler.GetCustomFoodQueue)
+			adminRoutes.POST("/custom-foods/:foodID/promote", catalogHandler.PromoteCustomFood)
+			adminRoutes.POST("/foods/merge", catalogHandler.MergeFoods)
+
+			adminRoutes.POST("/uploads/images", uploadHandler.UploadFoodImage)
+
+			adminRoutes.POST("/experiments", experimentHandler.CreateExperiment)
+			adminRoutes.GET("/experiments", experimentHandler.GetExperiments)
+			adminRoutes.PATCH("/experiments/:name/status", experimentHandler.UpdateStatus)
+			adminRoutes.GET("/experiments/:name/report", experimentHandler.GetReport)
+			adminRoutes.GET("/recommendations/ctr", recommendationHandler.GetCTR)
+		}
 	}
 }
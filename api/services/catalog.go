@@ -0,0 +1,387 @@
+// api/services/catalog.go
+// 관리자용 음식 카탈로그 정리 (커스텀 음식 승격 등)
+
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type CatalogService interface {
+	GetCustomFoodQueue(ctx context.Context, page int, count int) ([]models.CustomFood, error)
+	PromoteCustomFood(ctx context.Context, customFoodID string, req models.PromoteCustomFoodRequest) (*models.StandardFood, error)
+	MergeFoods(ctx context.Context, req models.MergeFoodsRequest) (*models.MergeFoodsResponse, error)
+}
+
+type catalogService struct {
+	foodRepo       repositories.FoodRepository
+	reviewRepo     repositories.ReviewRepository
+	categoryRepo   repositories.CategoryRepository
+	likeRepo       repositories.LikeRepository
+	recHistoryRepo repositories.RecHistoryRepository
+	experimentRepo repositories.ExperimentRepository
+}
+
+func NewCatalogService(
+	fr repositories.FoodRepository,
+	rr repositories.ReviewRepository,
+	cr repositories.CategoryRepository,
+	lr repositories.LikeRepository,
+	rhr repositories.RecHistoryRepository,
+	er repositories.ExperimentRepository,
+) CatalogService {
+	return &catalogService{
+		foodRepo:       fr,
+		reviewRepo:     rr,
+		categoryRepo:   cr,
+		likeRepo:       lr,
+		recHistoryRepo: rhr,
+		experimentRepo: er,
+	}
+}
+
+func (s *catalogService) GetCustomFoodQueue(ctx context.Context, page int, count int) ([]models.CustomFood, error) {
+	if page <= 0 {
+		return nil, apperr.BadRequest("page must be a positive integer", nil)
+	}
+	if count <= 0 || count > 100 {
+		return nil, apperr.BadRequest("invalid count", nil)
+	}
+
+	foods, err := s.foodRepo.FindCustomsByReviewCount(ctx, int64((page-1)*count), int64(count))
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch custom foods", err)
+	}
+
+	return foods, nil
+}
+
+func (s *catalogService) PromoteCustomFood(ctx context.Context, customFoodID string, req models.PromoteCustomFoodRequest) (*models.StandardFood, error) {
+	cID, err := primitive.ObjectIDFromHex(customFoodID)
+	if err != nil {
+		return nil, apperr.BadRequest("invalid food ID format", err)
+	}
+
+	if req.Speed != models.SpeedFast && req.Speed != models.SpeedSlow && req.Speed != models.SpeedBoth {
+		return nil, apperr.BadRequest("invalid speed type", nil)
+	}
+	if !isValidNutrition(req.Nutrition) {
+		return nil, apperr.BadRequest("nutrition values cannot be negative", nil)
+	}
+	if err := validateDietaryTags(req.Allergens, req.DietTags); err != nil {
+		return nil, err
+	}
+	if err := validateAttributes(req.Attributes); err != nil {
+		return nil, err
+	}
+	if err := validateTranslations(req.Translations); err != nil {
+		return nil, err
+	}
+	ingredients, err := normalizeIngredients(req.Ingredients)
+	if err != nil {
+		return nil, err
+	}
+
+	customFood, err := s.foodRepo.FindCustomByID(ctx, cID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch custom food", err)
+	}
+	if customFood == nil {
+		return nil, apperr.NotFound("custom food not found", nil)
+	}
+
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch categories", err)
+	}
+	if unknown := findUnknownCategories(categories, req.Categories); len(unknown) > 0 {
+		return nil, apperr.BadRequest("unknown categories: "+strings.Join(unknown, ", "), nil)
+	}
+
+	// 승격할 표준 음식 ID를 먼저 커스텀 음식에 기록해, 중간에 실패해도 재시도 시 같은 표준 음식을 이어서 사용
+	standardID, err := s.foodRepo.ReserveCustomPromotion(ctx, customFood.ID, primitive.NewObjectID())
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to reserve custom food promotion", err)
+	}
+
+	newFood, err := s.foodRepo.FindStandardByID(ctx, standardID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch standard food", err)
+	}
+	if newFood == nil {
+		name := strings.TrimSpace(req.Name)
+		if name == "" {
+			name = customFood.Name
+		}
+
+		newFood = &models.StandardFood{
+			ID:           standardID,
+			Name:         name,
+			ImageURL:     req.ImageURL,
+			Speed:        req.Speed,
+			Parents:      req.Parents,
+			Categories:   req.Categories,
+			Nutrition:    req.Nutrition,
+			Allergens:    req.Allergens,
+			DietTags:     req.DietTags,
+			Attributes:   req.Attributes,
+			Description:  req.Description,
+			Translations: req.Translations,
+			Ingredients:  ingredients,
+			LikeCount:    0,
+			ReviewCount:  0,
+			TotalRating:  0,
+
+			AllergensTagged: req.Allergens != nil,
+		}
+
+		err = s.foodRepo.CreateStandard(ctx, newFood)
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, apperr.Conflict("standard food with the same name already exists", err)
+			}
+			return nil, apperr.InternalServerError("failed to create standard food", err)
+		}
+	}
+
+	_, err = s.reviewRepo.ReplaceFoodItem(ctx, customFood.ID.Hex(), models.FoodTypeCustom, models.ReviewFoodItem{
+		FoodID:   newFood.ID.Hex(),
+		FoodName: newFood.Name,
+		Type:     models.FoodTypeStandard,
+	})
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to rewrite review food items", err)
+	}
+
+	// 커스텀 음식의 review_count 대신 실제 리뷰를 기준으로 통계를 다시 계산
+	if err := s.recomputeStandardReviewStats(ctx, newFood); err != nil {
+		return nil, err
+	}
+
+	err = s.foodRepo.DeleteCustom(ctx, customFood.ID)
+	if err != nil {
+		log.Printf("[WARNING] failed to delete promoted custom food %s: %v", customFood.ID.Hex(), err)
+	}
+
+	return newFood, nil
+}
+
+func (s *catalogService) recomputeStandardReviewStats(ctx context.Context, food *models.StandardFood) error {
+	reviewCount, totalRating, err := s.reviewRepo.AggregateFoodItemStats(ctx, food.ID.Hex(), models.FoodTypeStandard)
+	if err != nil {
+		return apperr.InternalServerError("failed to aggregate review stats", err)
+	}
+
+	err = s.foodRepo.SetStandardReviewStats(ctx, food.ID, reviewCount, totalRating)
+	if err != nil {
+		return apperr.InternalServerError("failed to update food review stats", err)
+	}
+
+	food.ReviewCount = reviewCount
+	food.TotalRating = totalRating
+	return nil
+}
+
+func (s *catalogService) MergeFoods(ctx context.Context, req models.MergeFoodsRequest) (*models.MergeFoodsResponse, error) {
+	sourceID, err := primitive.ObjectIDFromHex(req.SourceID)
+	if err != nil {
+		return nil, apperr.BadRequest("invalid source food ID format", err)
+	}
+	targetID, err := primitive.ObjectIDFromHex(req.TargetID)
+	if err != nil {
+		return nil, apperr.BadRequest("invalid target food ID format", err)
+	}
+	if sourceID == targetID {
+		return nil, apperr.BadRequest("cannot merge a food into itself", nil)
+	}
+
+	switch req.Type {
+	case models.FoodTypeStandard:
+		return s.mergeStandardFoods(ctx, sourceID, targetID)
+	case models.FoodTypeCustom:
+		return s.mergeCustomFoods(ctx, sourceID, targetID)
+	default:
+		return nil, apperr.BadRequest("invalid food type", nil)
+	}
+}
+
+// 두 음식을 모두 포함한 리뷰에서는 source 항목을 빼고, 나머지 리뷰의 source 항목은 target으로 교체
+func (s *catalogService) mergeReviewFoodItems(ctx context.Context, sourceID, targetID primitive.ObjectID, targetName string, foodType string) (int64, error) {
+	pulled, err := s.reviewRepo.PullFoodItemWhereBoth(ctx, sourceID.Hex(), targetID.Hex(), foodType)
+	if err != nil {
+		return 0, apperr.InternalServerError("failed to remove duplicated review food items", err)
+	}
+
+	replaced, err := s.reviewRepo.ReplaceFoodItem(ctx, sourceID.Hex(), foodType, models.ReviewFoodItem{
+		FoodID:   targetID.Hex(),
+		FoodName: targetName,
+		Type:     foodType,
+	})
+	if err != nil {
+		return 0, apperr.InternalServerError("failed to rewrite review food items", err)
+	}
+
+	return pulled + replaced, nil
+}
+
+func (s *catalogService) leaveRedirect(ctx context.Context, sourceID, targetID primitive.ObjectID, foodType string) error {
+	err := s.foodRepo.RepointRedirects(ctx, sourceID, targetID, foodType)
+	if err != nil {
+		return apperr.InternalServerError("failed to update existing redirects", err)
+	}
+
+	err = s.foodRepo.CreateRedirect(ctx, models.FoodRedirect{
+		ID:        primitive.NewObjectID(),
+		FromID:    sourceID,
+		ToID:      targetID,
+		Type:      foodType,
+		CreatedAt: time.Now(),
+	})
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return apperr.InternalServerError("failed to create food redirect", err)
+	}
+
+	return nil
+}
+
+func (s *catalogService) mergeStandardFoods(ctx context.Context, sourceID, targetID primitive.ObjectID) (*models.MergeFoodsResponse, error) {
+	foods, err := s.foodRepo.FindStandardByIDs(ctx, []primitive.ObjectID{sourceID, targetID})
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch standard foods", err)
+	}
+	var source, target *models.StandardFood
+	for _, f := range foods {
+		if f.ID == sourceID {
+			source = f
+		} else if f.ID == targetID {
+			target = f
+		}
+	}
+	if source == nil || target == nil {
+		return nil, apperr.NotFound("standard food not found", nil)
+	}
+
+	reviewsUpdated, err := s.mergeReviewFoodItems(ctx, sourceID, targetID, target.Name, models.FoodTypeStandard)
+	if err != nil {
+		return nil, err
+	}
+
+	likesMoved, err := s.likeRepo.ReassignFood(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to reassign likes", err)
+	}
+
+	err = s.recHistoryRepo.ReplaceFoodID(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to rewrite recommendation histories", err)
+	}
+
+	err = s.experimentRepo.ReplaceExposureFoodID(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to rewrite experiment exposures", err)
+	}
+
+	if err := s.recomputeStandardReviewStats(ctx, target); err != nil {
+		return nil, err
+	}
+
+	likeCount, err := s.likeRepo.CountByFoodID(ctx, targetID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to count likes", err)
+	}
+	err = s.foodRepo.SetStandardLikeCount(ctx, targetID, int(likeCount))
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to update like count", err)
+	}
+
+	if err := s.leaveRedirect(ctx, sourceID, targetID, models.FoodTypeStandard); err != nil {
+		return nil, err
+	}
+
+	err = s.foodRepo.DeleteStandard(ctx, sourceID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to delete merged standard food", err)
+	}
+
+	return &models.MergeFoodsResponse{
+		Type:           models.FoodTypeStandard,
+		SourceID:       sourceID.Hex(),
+		TargetID:       targetID.Hex(),
+		ReviewsUpdated: reviewsUpdated,
+		LikesMoved:     likesMoved,
+		ReviewCount:    target.ReviewCount,
+		TotalRating:    target.TotalRating,
+		LikeCount:      int(likeCount),
+	}, nil
+}
+
+func (s *catalogService) mergeCustomFoods(ctx context.Context, sourceID, targetID primitive.ObjectID) (*models.MergeFoodsResponse, error) {
+	source, err := s.foodRepo.FindCustomByID(ctx, sourceID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch custom food", err)
+	}
+	target, err := s.foodRepo.FindCustomByID(ctx, targetID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch custom food", err)
+	}
+	if source == nil || target == nil {
+		return nil, apperr.NotFound("custom food not found", nil)
+	}
+
+	reviewsUpdated, err := s.mergeReviewFoodItems(ctx, sourceID, targetID, target.Name, models.FoodTypeCustom)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewCount, totalRating, err := s.reviewRepo.AggregateFoodItemStats(ctx, targetID.Hex(), models.FoodTypeCustom)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to aggregate review stats", err)
+	}
+	err = s.foodRepo.SetCustomReviewCount(ctx, targetID, reviewCount)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to update custom food review count", err)
+	}
+
+	if err := s.leaveRedirect(ctx, sourceID, targetID, models.FoodTypeCustom); err != nil {
+		return nil, err
+	}
+
+	// source의 이름으로 같은 커스텀 음식이 다시 만들어지지 않도록 정규화된 이름을 target의 별칭으로 남김
+	targetName := utils.NormalizeFoodName(target.Name)
+	aliases := make([]string, 0, len(source.Aliases)+1)
+	for _, name := range append([]string{utils.NormalizeFoodName(source.Name)}, source.Aliases...) {
+		if name != targetName {
+			aliases = append(aliases, name)
+		}
+	}
+	if len(aliases) > 0 {
+		err = s.foodRepo.AddCustomAliases(ctx, targetID, aliases)
+		if err != nil {
+			return nil, apperr.InternalServerError("failed to add custom food aliases", err)
+		}
+	}
+
+	err = s.foodRepo.DeleteCustom(ctx, sourceID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to delete merged custom food", err)
+	}
+
+	return &models.MergeFoodsResponse{
+		Type:           models.FoodTypeCustom,
+		SourceID:       sourceID.Hex(),
+		TargetID:       targetID.Hex(),
+		ReviewsUpdated: reviewsUpdated,
+		ReviewCount:    reviewCount,
+		TotalRating:    totalRating,
+	}, nil
+}
@@ -0,0 +1,191 @@
+// api/services/category.go
+
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type CategoryService interface {
+	GetTree(ctx context.Context, locale string) ([]models.CategoryNode, error)
+	CreateCategories(ctx context.Context, req []models.CreateCategoryRequest) ([]*models.Category, error)
+}
+
+type categoryService struct {
+	categoryRepo repositories.CategoryRepository
+	foodRepo     repositories.FoodRepository
+}
+
+func NewCategoryService(cr repositories.CategoryRepository, fr repositories.FoodRepository) CategoryService {
+	return &categoryService{
+		categoryRepo: cr,
+		foodRepo:     fr,
+	}
+}
+
+// key -> 하위 카테고리 key 목록
+func buildChildrenMap(categories []models.Category) map[string][]string {
+	children := make(map[string][]string)
+	for _, c := range categories {
+		children[c.ParentKey] = append(children[c.ParentKey], c.Key)
+	}
+	return children
+}
+
+// 주어진 카테고리들과 그 모든 하위 카테고리의 key를 반환
+func expandCategoryKeys(categories []models.Category, keys []string) []string {
+	children := buildChildrenMap(categories)
+
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(keys))
+	queue := append([]string{}, keys...)
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, key)
+		queue = append(queue, children[key]...)
+	}
+
+	return result
+}
+
+func findUnknownCategories(categories []models.Category, keys []string) []string {
+	known := make(map[string]bool, len(categories))
+	for _, c := range categories {
+		known[c.Key] = true
+	}
+
+	var unknown []string
+	for _, key := range keys {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	return unknown
+}
+
+func (s *categoryService) GetTree(ctx context.Context, locale string) ([]models.CategoryNode, error) {
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch categories", err)
+	}
+
+	foodCounts, err := s.foodRepo.CountStandardsByCategory(ctx)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to count foods by category", err)
+	}
+
+	byParent := make(map[string][]models.Category)
+	for _, c := range categories {
+		byParent[c.ParentKey] = append(byParent[c.ParentKey], c)
+	}
+
+	// 하위 카테고리의 음식 수까지 합산
+	var build func(parentKey string) []models.CategoryNode
+	build = func(parentKey string) []models.CategoryNode {
+		nodes := make([]models.CategoryNode, 0, len(byParent[parentKey]))
+		for _, c := range byParent[parentKey] {
+			node := models.CategoryNode{
+				Key:       c.Key,
+				Name:      c.LocalizedName(locale),
+				Icon:      c.Icon,
+				Order:     c.Order,
+				FoodCount: foodCounts[c.Key],
+				Children:  build(c.Key),
+			}
+			for _, child := range node.Children {
+				node.FoodCount += child.FoodCount
+			}
+			nodes = append(nodes, node)
+		}
+		sort.SliceStable(nodes, func(i, j int) bool {
+			return nodes[i].Order < nodes[j].Order
+		})
+		return nodes
+	}
+
+	return build(""), nil
+}
+
+func (s *categoryService) CreateCategories(ctx context.Context, req []models.CreateCategoryRequest) ([]*models.Category, error) {
+	if len(req) == 0 {
+		return nil, apperr.BadRequest("categories list cannot be empty", nil)
+	}
+
+	existing, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch categories", err)
+	}
+	knownKeys := make(map[string]bool, len(existing)+len(req))
+	for _, c := range existing {
+		knownKeys[c.Key] = true
+	}
+
+	var newCategories []*models.Category
+	var docs []interface{}
+
+	for _, categoryReq := range req {
+		key := strings.TrimSpace(categoryReq.Key)
+		if key == "" {
+			return nil, apperr.BadRequest("category key cannot be empty", nil)
+		}
+		if knownKeys[key] {
+			return nil, apperr.Conflict("category already exists: "+key, nil)
+		}
+		knownKeys[key] = true
+
+		for locale, t := range categoryReq.Translations {
+			if locale == models.DefaultLocale || !models.IsSupportedLocale(locale) {
+				return nil, apperr.BadRequest("unsupported translation locale: "+locale, nil)
+			}
+			if strings.TrimSpace(t.Name) == "" {
+				return nil, apperr.BadRequest("translated name cannot be empty: "+locale, nil)
+			}
+		}
+
+		newCategories = append(newCategories, &models.Category{
+			ID:           primitive.NewObjectID(),
+			Key:          key,
+			Name:         categoryReq.Name,
+			Icon:         categoryReq.Icon,
+			ParentKey:    categoryReq.ParentKey,
+			Order:        categoryReq.Order,
+			Translations: categoryReq.Translations,
+			CreatedAt:    time.Now(),
+		})
+	}
+
+	// 같은 요청 안에서 부모를 먼저 만드는 경우도 허용
+	for _, c := range newCategories {
+		if c.ParentKey != "" && !knownKeys[c.ParentKey] {
+			return nil, apperr.BadRequest("parent category not found: "+c.ParentKey, nil)
+		}
+		if c.ParentKey == c.Key {
+			return nil, apperr.BadRequest("category cannot be its own parent: "+c.Key, nil)
+		}
+		docs = append(docs, c)
+	}
+
+	err = s.categoryRepo.CreateMany(ctx, docs)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, apperr.Conflict("category already exists", err)
+		}
+		return nil, apperr.InternalServerError("failed to create categories", err)
+	}
+
+	return newCategories, nil
+}
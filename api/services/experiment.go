@@ -0,0 +1,323 @@
+// api/services/experiment.go
+
+package services
+
+import (
+	"context"
+	"hash/fnv"
+	"regexp"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/config"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var experimentNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+type ExperimentService interface {
+	CreateExperiment(ctx context.Context, req models.CreateExperimentRequest) (*models.Experiment, error)
+	GetExperiments(ctx context.Context) ([]models.Experiment, error)
+	UpdateStatus(ctx context.Context, name string, status string) (*models.Experiment, error)
+	GetReport(ctx context.Context, name string, windowHours int) (*models.ExperimentReport, error)
+}
+
+type experimentService struct {
+	experimentRepo repositories.ExperimentRepository
+}
+
+func NewExperimentService(er repositories.ExperimentRepository) ExperimentService {
+	return &experimentService{
+		experimentRepo: er,
+	}
+}
+
+func (s *experimentService) CreateExperiment(ctx context.Context, req models.CreateExperimentRequest) (*models.Experiment, error) {
+	if !experimentNamePattern.MatchString(req.Name) {
+		return nil, apperr.BadRequest("invalid experiment name", nil)
+	}
+
+	if len(req.Variants) < 2 {
+		return nil, apperr.BadRequest("at least two variants are required", nil)
+	}
+
+	totalWeight := 0
+	seen := make(map[string]bool)
+	for _, v := range req.Variants {
+		if !experimentNamePattern.MatchString(v.Name) {
+			return nil, apperr.BadRequest("invalid variant name: "+v.Name, nil)
+		}
+		if seen[v.Name] {
+			return nil, apperr.BadRequest("duplicate variant name: "+v.Name, nil)
+		}
+		seen[v.Name] = true
+
+		if v.Weight <= 0 {
+			return nil, apperr.BadRequest("variant weight must be positive: "+v.Name, nil)
+		}
+		totalWeight += v.Weight
+
+		if err := validateRecommenderParams(v.Params); err != nil {
+			return nil, err
+		}
+	}
+	if totalWeight != 100 {
+		return nil, apperr.BadRequest("variant weights must sum to 100", nil)
+	}
+
+	experiment := &models.Experiment{
+		ID:          primitive.NewObjectID(),
+		Name:        req.Name,
+		Description: req.Description,
+		Variants:    req.Variants,
+		Status:      models.ExperimentStatusDraft,
+		CreatedAt:   time.Now(),
+	}
+
+	err := s.experimentRepo.Create(ctx, experiment)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, apperr.Conflict("experiment already exists", err)
+		}
+		return nil, apperr.InternalServerError("failed to create experiment", err)
+	}
+
+	return experiment, nil
+}
+
+func (s *experimentService) GetExperiments(ctx context.Context) ([]models.Experiment, error) {
+	experiments, err := s.experimentRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch experiments", err)
+	}
+	return experiments, nil
+}
+
+// draft -> running -> stopped 순서로만 바뀜
+func (s *experimentService) UpdateStatus(ctx context.Context, name string, status string) (*models.Experiment, error) {
+	experiment, err := s.getExperiment(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var changed bool
+	switch status {
+	case models.ExperimentStatusRunning:
+		changed, err = s.experimentRepo.Start(ctx, experiment.ID, now)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, apperr.Conflict("another experiment is already running", err)
+		}
+	case models.ExperimentStatusStopped:
+		changed, err = s.experimentRepo.Stop(ctx, experiment.ID, now)
+	default:
+		return nil, apperr.BadRequest("invalid experiment status", nil)
+	}
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to update experiment status", err)
+	}
+	if !changed {
+		return nil, apperr.Conflict("cannot change experiment from "+experiment.Status+" to "+status, nil)
+	}
+
+	return s.getExperiment(ctx, name)
+}
+
+func (s *experimentService) GetReport(ctx context.Context, name string, windowHours int) (*models.ExperimentReport, error) {
+	if windowHours <= 0 || windowHours > 72 {
+		return nil, apperr.BadRequest("invalid window hours", nil)
+	}
+
+	experiment, err := s.getExperiment(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if experiment.StartedAt == nil {
+		return nil, apperr.BadRequest("experiment has not started", nil)
+	}
+
+	// 보존 기간이 지난 노출은 남아 있지 않음
+	since := *experiment.StartedAt
+	if retained := time.Now().Add(-models.ExperimentExposureRetention); since.Before(retained) {
+		since = retained
+	}
+
+	conversions, err := s.experimentRepo.AggregateConversion(ctx, experiment.Name, since, time.Duration(windowHours)*time.Hour)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to aggregate experiment conversion", err)
+	}
+
+	for i := range conversions {
+		if conversions[i].Impressions > 0 {
+			impressions := float64(conversions[i].Impressions)
+			conversions[i].LikeRate = float64(conversions[i].Liked) / impressions
+			conversions[i].ReviewRate = float64(conversions[i].Reviewed) / impressions
+		}
+	}
+
+	return &models.ExperimentReport{
+		Experiment:  *experiment,
+		Since:       since,
+		WindowHours: windowHours,
+		Variants:    conversions,
+	}, nil
+}
+
+func (s *experimentService) getExperiment(ctx context.Context, name string) (*models.Experiment, error) {
+	experiment, err := s.experimentRepo.FindByName(ctx, name)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch experiment", err)
+	}
+	if experiment == nil {
+		return nil, apperr.NotFound("experiment not found", nil)
+	}
+	return experiment, nil
+}
+
+func validateRecommenderParams(p models.RecommenderParams) error {
+	if !isKnownRecommender(p.Strategy) {
+		return apperr.BadRequest("unknown recommender strategy: "+p.Strategy, nil)
+	}
+	if p.CandidateMultiplier != nil && (*p.CandidateMultiplier < 1 || *p.CandidateMultiplier > 20) {
+		return apperr.BadRequest("candidateMultiplier must be between 1 and 20", nil)
+	}
+	if p.HistoryDays != nil && (*p.HistoryDays < 0 || *p.HistoryDays > 7) {
+		return apperr.BadRequest("historyDays must be between 0 and 7", nil)
+	}
+	if p.DiversityDays != nil && (*p.DiversityDays < 0 || *p.DiversityDays > 7) {
+		return apperr.BadRequest("diversityDays must be between 0 and 7", nil)
+	}
+	if p.DecayFloor != nil && *p.DecayFloor < 0 {
+		return apperr.BadRequest("decayFloor must not be negative", nil)
+	}
+	if p.Jitter != nil && (*p.Jitter < 0 || *p.Jitter >= 1) {
+		return apperr.BadRequest("jitter must be in [0, 1)", nil)
+	}
+	if p.CTRWeight != nil && (*p.CTRWeight < 0 || *p.CTRWeight > 2) {
+		return apperr.BadRequest("ctrWeight must be between 0 and 2", nil)
+	}
+	if p.TasteWeight != nil && (*p.TasteWeight < 0 || *p.TasteWeight > 1) {
+		return apperr.BadRequest("tasteWeight must be between 0 and 1", nil)
+	}
+	if p.Exploration != nil && (*p.Exploration < 0 || *p.Exploration > 1) {
+		return apperr.BadRequest("exploration must be between 0 and 1", nil)
+	}
+	if p.CFCandidates != nil && (*p.CFCandidates < 0 || *p.CFCandidates > 10) {
+		return apperr.BadRequest("cfCandidates must be between 0 and 10", nil)
+	}
+	if p.CFWeight != nil && (*p.CFWeight < 0 || *p.CFWeight > 2) {
+		return apperr.BadRequest("cfWeight must be between 0 and 2", nil)
+	}
+	if p.AteDays != nil && (*p.AteDays < 0 || *p.AteDays > 7) {
+		return apperr.BadRequest("ateDays must be between 0 and 7", nil)
+	}
+	if p.AteDecayFloor != nil && *p.AteDecayFloor < 0 {
+		return apperr.BadRequest("ateDecayFloor must not be negative", nil)
+	}
+	if p.MealTimeWeight != nil && (*p.MealTimeWeight < 0 || *p.MealTimeWeight > 2) {
+		return apperr.BadRequest("mealTimeWeight must be between 0 and 2", nil)
+	}
+
+	if err := validateDecayBuckets("decayBuckets", p.DecayBuckets); err != nil {
+		return err
+	}
+	return validateDecayBuckets("ateDecayBuckets", p.AteDecayBuckets)
+}
+
+func validateDecayBuckets(field string, buckets []models.DecayBucket) error {
+	prev := 0.0
+	for _, bucket := range buckets {
+		if bucket.WithinHours <= prev {
+			return apperr.BadRequest(field+" must be in increasing order of withinHours", nil)
+		}
+		if bucket.Weight < 0 {
+			return apperr.BadRequest(field+" weight must not be negative", nil)
+		}
+		prev = bucket.WithinHours
+	}
+	return nil
+}
+
+// 비어 있지 않은 값만 기본 설정에 덮어씀
+func applyRecommenderParams(base config.RecommenderConfig, p models.RecommenderParams) config.RecommenderConfig {
+	cfg := base
+	if p.Strategy != "" {
+		cfg.Strategy = p.Strategy
+	}
+	if p.CandidateMultiplier != nil {
+		cfg.CandidateMultiplier = *p.CandidateMultiplier
+	}
+	if p.HistoryDays != nil {
+		cfg.HistoryDays = *p.HistoryDays
+	}
+	if len(p.DecayBuckets) > 0 {
+		cfg.DecayBuckets = toConfigDecayBuckets(p.DecayBuckets)
+	}
+	if p.DecayFloor != nil {
+		cfg.DecayFloor = *p.DecayFloor
+	}
+	if p.Jitter != nil {
+		cfg.Jitter = *p.Jitter
+	}
+	if p.DiversityDays != nil {
+		cfg.DiversityDays = *p.DiversityDays
+	}
+	if p.CTRWeight != nil {
+		cfg.CTRWeight = *p.CTRWeight
+	}
+	if p.TasteWeight != nil {
+		cfg.TasteWeight = *p.TasteWeight
+	}
+	if p.Exploration != nil {
+		cfg.Exploration = *p.Exploration
+	}
+	if p.CFCandidates != nil {
+		cfg.CFCandidates = *p.CFCandidates
+	}
+	if p.CFWeight != nil {
+		cfg.CFWeight = *p.CFWeight
+	}
+	if p.AteDays != nil {
+		cfg.AteDays = *p.AteDays
+	}
+	if len(p.AteDecayBuckets) > 0 {
+		cfg.AteDecayBuckets = toConfigDecayBuckets(p.AteDecayBuckets)
+	}
+	if p.AteDecayFloor != nil {
+		cfg.AteDecayFloor = *p.AteDecayFloor
+	}
+	if p.MealTimeWeight != nil {
+		cfg.MealTimeWeight = *p.MealTimeWeight
+	}
+	return cfg
+}
+
+func toConfigDecayBuckets(buckets []models.DecayBucket) []config.DecayBucket {
+	result := make([]config.DecayBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		result = append(result, config.DecayBucket{
+			Within: time.Duration(bucket.WithinHours * float64(time.Hour)),
+			Weight: bucket.Weight,
+		})
+	}
+	return result
+}
+
+// 실험 이름과 사용자 ID를 해시해 0~99 버킷을 정하므로, 같은 사용자는 실험 내내 같은 변형을 받음
+func assignVariant(experiment *models.Experiment, userID primitive.ObjectID) *models.ExperimentVariant {
+	h := fnv.New32a()
+	h.Write([]byte(experiment.Name + ":" + userID.Hex()))
+	bucket := int(h.Sum32() % 100)
+
+	cumulative := 0
+	for i := range experiment.Variants {
+		cumulative += experiment.Variants[i].Weight
+		if bucket < cumulative {
+			return &experiment.Variants[i]
+		}
+	}
+	return nil
+}
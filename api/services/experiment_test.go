@@ -0,0 +1,115 @@
+// api/services/experiment_test.go
+
+package services
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestAssignVariant(t *testing.T) {
+	const users = 10000
+
+	userID := func(i int) primitive.ObjectID {
+		var id primitive.ObjectID
+		binary.BigEndian.PutUint32(id[8:], uint32(i))
+		return id
+	}
+	variants := func(weights ...int) []models.ExperimentVariant {
+		vs := make([]models.ExperimentVariant, 0, len(weights))
+		for i, w := range weights {
+			vs = append(vs, models.ExperimentVariant{Name: string(rune('a' + i)), Weight: w})
+		}
+		return vs
+	}
+
+	tests := []struct {
+		name     string
+		variants []models.ExperimentVariant
+		// 변형 이름별 기대 비율 (허용 오차 ±3%p)
+		wantShares map[string]float64
+		wantNil    bool
+	}{
+		{
+			name:       "single variant takes everyone",
+			variants:   variants(100),
+			wantShares: map[string]float64{"a": 1},
+		},
+		{
+			name:       "even split",
+			variants:   variants(50, 50),
+			wantShares: map[string]float64{"a": 0.5, "b": 0.5},
+		},
+		{
+			name:       "uneven split",
+			variants:   variants(10, 30, 60),
+			wantShares: map[string]float64{"a": 0.1, "b": 0.3, "c": 0.6},
+		},
+		{
+			name:       "zero weight variant gets nobody",
+			variants:   variants(0, 100),
+			wantShares: map[string]float64{"b": 1},
+		},
+		{
+			name:     "no variants",
+			variants: nil,
+			wantNil:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			experiment := &models.Experiment{Name: "feed-test", Variants: tt.variants}
+
+			counts := make(map[string]int)
+			for i := 0; i < users; i++ {
+				v := assignVariant(experiment, userID(i))
+				if tt.wantNil {
+					if v != nil {
+						t.Fatalf("user %d got variant %q, want none", i, v.Name)
+					}
+					continue
+				}
+				if v == nil {
+					t.Fatalf("user %d got no variant", i)
+				}
+				if again := assignVariant(experiment, userID(i)); again != v {
+					t.Fatalf("user %d got %q then %q", i, v.Name, again.Name)
+				}
+				counts[v.Name]++
+			}
+
+			for name, count := range counts {
+				if _, ok := tt.wantShares[name]; !ok {
+					t.Errorf("variant %q got %d users, want none", name, count)
+				}
+			}
+			for name, want := range tt.wantShares {
+				got := float64(counts[name]) / users
+				if math.Abs(got-want) > 0.03 {
+					t.Errorf("variant %q share = %.3f, want %.2f", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAssignVariantDependsOnExperiment(t *testing.T) {
+	first := &models.Experiment{Name: "feed-a", Variants: []models.ExperimentVariant{{Name: "x", Weight: 50}, {Name: "y", Weight: 50}}}
+	second := &models.Experiment{Name: "feed-b", Variants: first.Variants}
+
+	// 실험마다 버킷이 독립적이어야 한 실험의 대조군이 다른 실험에서도 늘 대조군이 되지 않음
+	differ := 0
+	for i := byte(0); i < 200; i++ {
+		if assignVariant(first, testObjectID(i)).Name != assignVariant(second, testObjectID(i)).Name {
+			differ++
+		}
+	}
+	if differ < 60 || differ > 140 {
+		t.Errorf("%d of 200 users changed variant between experiments, want about half", differ)
+	}
+}
@@ -5,24 +5,37 @@ package services
 import (
 	"context"
 	"log"
-	"math/rand"
+	"math"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/seojoonrp/bapddang-server/api/repositories"
 	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/config"
 	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/utils"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type FoodService interface {
-	GetStandardByID(ctx context.Context, id string) (*models.StandardFood, error)
+	GetStandardByID(ctx context.Context, id string, locale string) (*models.StandardFood, error)
 	CreateStandards(ctx context.Context, req []models.CreateStandardFoodRequest) ([]*models.StandardFood, error)
 	ResolveFoodItems(ctx context.Context, names []string) ([]models.ReviewFoodItem, error)
+	UpdateNutrition(ctx context.Context, foodID string, nutrition *models.Nutrition) (*models.StandardFood, error)
+	UpdateStandardDetails(ctx context.Context, foodID string, req models.UpdateStandardFoodRequest) (*models.StandardFood, error)
 
-	GetMainFeedFoods(ctx context.Context, userID string, speed string, count int) ([]models.FoodLikeResponse, error)
-	GetFoodsByCategories(ctx context.Context, userID string, speed string, categories []string, count int) ([]models.FoodLikeResponse, error)
+	GetMainFeedFoods(ctx context.Context, userID string, speed string, count int, mealTime string, timezone string, locale string) (*models.FoodFeedResult, error)
+	GetGroupFeedFoods(ctx context.Context, userID string, query models.GroupFeedQuery, locale string) (*models.FoodFeedResult, error)
+	GetFoodsByCategories(ctx context.Context, userID string, speed string, categories []string, count int, locale string) (*models.FoodFeedResult, error)
+	BrowseFoods(ctx context.Context, userID string, query models.BrowseFoodsQuery) (*models.BrowseFoodsResponse, error)
+	SearchFoods(ctx context.Context, userID string, query string, count int, locale string) ([]models.FoodLikeResponse, error)
+
+	GetFoodDetail(ctx context.Context, userID string, foodID string, reviewCount int, locale string) (*models.FoodDetailResponse, error)
+	GetFoodReviews(ctx context.Context, foodID string, cursor string, count int) (*models.ReviewSnippetPage, error)
+	GetSimilarFoods(ctx context.Context, userID string, foodID string, speed string, count int, locale string) ([]models.FoodLikeResponse, error)
+	GetFridgeFoods(ctx context.Context, userID string, ingredients []string, count int, locale string) ([]models.FridgeFood, error)
 
 	IncrementLikeCount(ctx context.Context, foodID string) error
 	DecrementLikeCount(ctx context.Context, foodID string) error
@@ -31,30 +44,97 @@ type FoodService interface {
 type foodService struct {
 	foodRepo       repositories.FoodRepository
 	likeRepo       repositories.LikeRepository
+	reviewRepo     repositories.ReviewRepository
 	recHistoryRepo repositories.RecHistoryRepository
+	categoryRepo   repositories.CategoryRepository
+	userRepo       repositories.UserRepository
+	experimentRepo repositories.ExperimentRepository
+	recEventRepo   repositories.RecEventRepository
+	hideRepo       repositories.HideRepository
+	mealGroupRepo  repositories.MealGroupRepository
+	recommender    Recommender
+	newRecommender RecommenderFactory
+	recConfig      config.RecommenderConfig
 	cacheLock      sync.RWMutex
+	experiments    experimentCache
+}
+
+// 실행 중인 실험과 변형별 추천 전략. experimentCacheTTL마다 다시 불러옴
+type experimentCache struct {
+	experiment   *models.Experiment
+	recommenders map[string]Recommender
+	loadedAt     time.Time
 }
 
+const experimentCacheTTL = time.Minute
+
 func NewFoodService(
 	ctx context.Context,
 	fr repositories.FoodRepository,
 	lr repositories.LikeRepository,
+	rr repositories.ReviewRepository,
 	rhr repositories.RecHistoryRepository,
+	cr repositories.CategoryRepository,
+	ur repositories.UserRepository,
+	er repositories.ExperimentRepository,
+	rer repositories.RecEventRepository,
+	hr repositories.HideRepository,
+	mgr repositories.MealGroupRepository,
+	rec Recommender,
+	recFactory RecommenderFactory,
+	recCfg config.RecommenderConfig,
 ) FoodService {
 	return &foodService{
 		foodRepo:       fr,
 		likeRepo:       lr,
+		reviewRepo:     rr,
 		recHistoryRepo: rhr,
+		categoryRepo:   cr,
+		userRepo:       ur,
+		experimentRepo: er,
+		recEventRepo:   rer,
+		hideRepo:       hr,
+		mealGroupRepo:  mgr,
+		recommender:    rec,
+		newRecommender: recFactory,
+		recConfig:      recCfg,
 	}
 }
 
-func (s *foodService) GetStandardByID(ctx context.Context, foodID string) (*models.StandardFood, error) {
+func (s *foodService) GetStandardByID(ctx context.Context, foodID string, locale string) (*models.StandardFood, error) {
 	fID, err := primitive.ObjectIDFromHex(foodID)
 	if err != nil {
 		return nil, apperr.BadRequest("invalid food ID format", err)
 	}
 
-	food, err := s.foodRepo.FindStandardByID(ctx, fID)
+	food, err := findStandardFollowingRedirect(ctx, s.foodRepo, fID)
+	if err != nil {
+		return nil, err
+	}
+
+	food.Localize(locale)
+	return food, nil
+}
+
+func findStandardFollowingRedirect(ctx context.Context, foodRepo repositories.FoodRepository, fID primitive.ObjectID) (*models.StandardFood, error) {
+	food, err := foodRepo.FindStandardByID(ctx, fID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch standard food", err)
+	}
+	if food != nil {
+		return food, nil
+	}
+
+	// 병합된 음식의 예전 ID로 조회하는 경우
+	redirect, err := foodRepo.FindRedirect(ctx, fID, models.FoodTypeStandard)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch food redirect", err)
+	}
+	if redirect == nil {
+		return nil, apperr.NotFound("food not found", nil)
+	}
+
+	food, err = foodRepo.FindStandardByID(ctx, redirect.ToID)
 	if err != nil {
 		return nil, apperr.InternalServerError("failed to fetch standard food", err)
 	}
@@ -66,26 +146,60 @@ func (s *foodService) GetStandardByID(ctx context.Context, foodID string) (*mode
 }
 
 func (s *foodService) CreateStandards(ctx context.Context, req []models.CreateStandardFoodRequest) ([]*models.StandardFood, error) {
+	categories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch categories", err)
+	}
+
 	var newFoods []*models.StandardFood
 	var docs []interface{}
 
 	for _, foodReq := range req {
+		if unknown := findUnknownCategories(categories, foodReq.Categories); len(unknown) > 0 {
+			return nil, apperr.BadRequest("unknown categories for food "+foodReq.Name+": "+strings.Join(unknown, ", "), nil)
+		}
+		if !isValidNutrition(foodReq.Nutrition) {
+			return nil, apperr.BadRequest("nutrition values cannot be negative for food: "+foodReq.Name, nil)
+		}
+		if err := validateDietaryTags(foodReq.Allergens, foodReq.DietTags); err != nil {
+			return nil, err
+		}
+		if err := validateAttributes(foodReq.Attributes); err != nil {
+			return nil, err
+		}
+		if err := validateTranslations(foodReq.Translations); err != nil {
+			return nil, err
+		}
+		ingredients, err := normalizeIngredients(foodReq.Ingredients)
+		if err != nil {
+			return nil, err
+		}
+
 		newFood := &models.StandardFood{
-			ID:          primitive.NewObjectID(),
-			Name:        foodReq.Name,
-			ImageURL:    foodReq.ImageURL,
-			Speed:       foodReq.Speed,
-			Parents:     foodReq.Parents,
-			Categories:  foodReq.Categories,
-			LikeCount:   0,
-			ReviewCount: 0,
-			TotalRating: 0,
+			ID:           primitive.NewObjectID(),
+			Name:         foodReq.Name,
+			ImageURL:     foodReq.ImageURL,
+			Speed:        foodReq.Speed,
+			Parents:      foodReq.Parents,
+			Categories:   foodReq.Categories,
+			Nutrition:    foodReq.Nutrition,
+			Allergens:    foodReq.Allergens,
+			DietTags:     foodReq.DietTags,
+			Attributes:   foodReq.Attributes,
+			Description:  foodReq.Description,
+			Translations: foodReq.Translations,
+			Ingredients:  ingredients,
+			LikeCount:    0,
+			ReviewCount:  0,
+			TotalRating:  0,
+
+			AllergensTagged: foodReq.Allergens != nil,
 		}
 		newFoods = append(newFoods, newFood)
 		docs = append(docs, newFood)
 	}
 
-	err := s.foodRepo.CreateStandards(ctx, docs)
+	err = s.foodRepo.CreateStandards(ctx, docs)
 	if err != nil {
 		return nil, apperr.InternalServerError("failed to create standard foods", err)
 	}
@@ -93,44 +207,186 @@ func (s *foodService) CreateStandards(ctx context.Context, req []models.CreateSt
 	return newFoods, nil
 }
 
+func (s *foodService) UpdateNutrition(ctx context.Context, foodID string, nutrition *models.Nutrition) (*models.StandardFood, error) {
+	fID, err := primitive.ObjectIDFromHex(foodID)
+	if err != nil {
+		return nil, apperr.BadRequest("invalid food ID format", err)
+	}
+
+	if !isValidNutrition(nutrition) {
+		return nil, apperr.BadRequest("nutrition values cannot be negative", nil)
+	}
+
+	food, err := s.foodRepo.FindStandardByID(ctx, fID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch standard food", err)
+	}
+	if food == nil {
+		return nil, apperr.NotFound("food not found", nil)
+	}
+
+	err = s.foodRepo.UpdateStandardNutrition(ctx, fID, nutrition)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to update nutrition", err)
+	}
+
+	food.Nutrition = nutrition
+	return food, nil
+}
+
+func (s *foodService) UpdateStandardDetails(ctx context.Context, foodID string, req models.UpdateStandardFoodRequest) (*models.StandardFood, error) {
+	fID, err := primitive.ObjectIDFromHex(foodID)
+	if err != nil {
+		return nil, apperr.BadRequest("invalid food ID format", err)
+	}
+
+	if req.ImageURL != nil && strings.TrimSpace(*req.ImageURL) == "" {
+		return nil, apperr.BadRequest("image URL cannot be empty", nil)
+	}
+
+	var allergens, diets []string
+	if req.Allergens != nil {
+		allergens = *req.Allergens
+	}
+	if req.DietTags != nil {
+		diets = *req.DietTags
+	}
+	if err := validateDietaryTags(allergens, diets); err != nil {
+		return nil, err
+	}
+	if req.Attributes != nil {
+		if err := validateAttributes(*req.Attributes); err != nil {
+			return nil, err
+		}
+	}
+	if req.Translations != nil {
+		if err := validateTranslations(*req.Translations); err != nil {
+			return nil, err
+		}
+	}
+	if req.Ingredients != nil {
+		ingredients, err := normalizeIngredients(*req.Ingredients)
+		if err != nil {
+			return nil, err
+		}
+		req.Ingredients = &ingredients
+	}
+
+	food, err := s.foodRepo.UpdateStandardDetails(ctx, fID, req)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to update standard food", err)
+	}
+	if food == nil {
+		return nil, apperr.NotFound("food not found", nil)
+	}
+
+	return food, nil
+}
+
+// 이름들을 normalized_name 기준 upsert로 커스텀 음식에 대응시켜, 동시 요청에도 같은 이름의 커스텀 음식이 하나만 생기도록 함
+// 병합되어 다른 커스텀 음식의 별칭이 된 이름은 새로 만들지 않고 그 음식에 대응시킴
 func (s *foodService) resolveCustomFoods(ctx context.Context, names []string) (map[string]models.CustomFood, error) {
 	result := make(map[string]models.CustomFood, len(names))
 	if len(names) == 0 {
 		return result, nil
 	}
 
-	existing, err := s.foodRepo.FindCustomByNames(ctx, names)
-	if err != nil {
-		return nil, apperr.InternalServerError("failed to fetch custom foods", err)
+	normalizedNames := make([]string, 0, len(names))
+	displayNames := make(map[string]string, len(names))
+	for _, name := range names {
+		normalized := utils.NormalizeFoodName(name)
+		if normalized == "" {
+			return nil, apperr.BadRequest("food name cannot be blank", nil)
+		}
+		if _, ok := displayNames[normalized]; ok {
+			continue
+		}
+		displayNames[normalized] = strings.TrimSpace(name)
+		normalizedNames = append(normalizedNames, normalized)
 	}
-	for _, f := range existing {
-		result[f.Name] = *f
+
+	byNormalized := make(map[string]models.CustomFood, len(normalizedNames))
+	if err := s.indexCustomFoods(ctx, normalizedNames, byNormalized); err != nil {
+		return nil, err
 	}
 
-	var docs []interface{}
-	for _, name := range names {
-		if _, ok := result[name]; ok {
+	docs := make([]models.CustomFood, 0, len(normalizedNames))
+	for _, normalized := range normalizedNames {
+		if _, ok := byNormalized[normalized]; ok {
 			continue
 		}
-		newFood := models.CustomFood{
-			ID:          primitive.NewObjectID(),
-			Name:        name,
-			ReviewCount: 0,
-			CreatedAt:   time.Now(),
-		}
-		docs = append(docs, newFood)
-		result[name] = newFood
+		docs = append(docs, models.CustomFood{
+			ID:             primitive.NewObjectID(),
+			Name:           displayNames[normalized],
+			NormalizedName: normalized,
+			ReviewCount:    0,
+			CreatedAt:      time.Now(),
+		})
 	}
 
 	if len(docs) > 0 {
-		if err := s.foodRepo.CreateCustoms(ctx, docs); err != nil {
+		failed, err := s.foodRepo.UpsertCustoms(ctx, docs)
+		if err != nil {
 			return nil, apperr.InternalServerError("failed to create custom foods", err)
 		}
+
+		// 일부만 실패한 경우 실패한 이름만 한 번 더 시도
+		if len(failed) > 0 {
+			failedSet := make(map[string]bool, len(failed))
+			for _, normalized := range failed {
+				failedSet[normalized] = true
+			}
+			retryDocs := make([]models.CustomFood, 0, len(failed))
+			for _, doc := range docs {
+				if failedSet[doc.NormalizedName] {
+					retryDocs = append(retryDocs, doc)
+				}
+			}
+
+			failed, err = s.foodRepo.UpsertCustoms(ctx, retryDocs)
+			if err != nil {
+				return nil, apperr.InternalServerError("failed to create custom foods", err)
+			}
+			if len(failed) > 0 {
+				return nil, apperr.InternalServerError("failed to create custom foods: "+strings.Join(failed, ", "), nil)
+			}
+		}
+
+		created := make([]string, 0, len(docs))
+		for _, doc := range docs {
+			created = append(created, doc.NormalizedName)
+		}
+		if err := s.indexCustomFoods(ctx, created, byNormalized); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range names {
+		food, ok := byNormalized[utils.NormalizeFoodName(name)]
+		if !ok {
+			return nil, apperr.InternalServerError("failed to resolve custom food "+name, nil)
+		}
+		result[name] = food
 	}
 
 	return result, nil
 }
 
+// 정규화된 이름이나 별칭으로 커스텀 음식을 찾아 정규화된 이름별로 기록
+func (s *foodService) indexCustomFoods(ctx context.Context, normalizedNames []string, byNormalized map[string]models.CustomFood) error {
+	foods, err := s.foodRepo.FindCustomByNormalizedNames(ctx, normalizedNames)
+	if err != nil {
+		return apperr.InternalServerError("failed to fetch custom foods", err)
+	}
+	for _, f := range foods {
+		byNormalized[f.NormalizedName] = *f
+		for _, alias := range f.Aliases {
+			byNormalized[alias] = *f
+		}
+	}
+	return nil
+}
+
 func (s *foodService) ResolveFoodItems(ctx context.Context, names []string) ([]models.ReviewFoodItem, error) {
 	if len(names) == 0 {
 		return nil, apperr.BadRequest("names list cannot be empty", nil)
@@ -141,7 +397,15 @@ func (s *foodService) ResolveFoodItems(ctx context.Context, names []string) ([]m
 	if err != nil {
 		return nil, apperr.InternalServerError("failed to fetch standard foods by name", err)
 	}
+	// 번역된 이름으로 입력해도 같은 표준 음식으로 연결 (저장되는 이름은 기본 언어 이름)
 	standardByName := make(map[string]models.StandardFood, len(standardFoods))
+	for _, f := range standardFoods {
+		for _, t := range f.Translations {
+			if t.Name != "" {
+				standardByName[t.Name] = *f
+			}
+		}
+	}
 	for _, f := range standardFoods {
 		standardByName[f.Name] = *f
 	}
@@ -187,116 +451,169 @@ func (s *foodService) ResolveFoodItems(ctx context.Context, names []string) ([]m
 	return result, nil
 }
 
-func (s *foodService) getRecommendedFoods(ctx context.Context, userID primitive.ObjectID, speed string, count int) ([]models.StandardFood, error) {
-	candidateCount := count * 7
-	candidates, err := s.foodRepo.GetRandomStandards(ctx, speed, nil, candidateCount)
-	if err != nil {
-		return nil, apperr.InternalServerError("failed to get candidate foods", err)
-	}
+func (s *foodService) getUser(ctx context.Context, userID primitive.ObjectID) (*models.User, error) {
+	return fetchUser(ctx, s.userRepo, userID)
+}
 
-	historyMap, err := s.recHistoryRepo.GetRecentFoodIDsMap(ctx, userID, 2)
+func fetchUser(ctx context.Context, userRepo repositories.UserRepository, userID primitive.ObjectID) (*models.User, error) {
+	user, err := userRepo.FindByID(ctx, userID)
 	if err != nil {
-		return nil, apperr.InternalServerError("failed to get recommendation history", err)
+		return nil, apperr.InternalServerError("failed to fetch user", err)
+	}
+	if user == nil {
+		return nil, apperr.NotFound("user not found", nil)
 	}
+	return user, nil
+}
 
-	type scoredFood struct {
-		food  models.StandardFood
-		score float64
+// 유저가 직접 설정한 언어가 Accept-Language보다 우선
+func preferredLocale(user *models.User, requested string) string {
+	if user != nil && user.Locale != "" {
+		return user.Locale
 	}
-	scoredList := make([]scoredFood, 0, len(candidates))
-	now := time.Now()
+	if requested == "" {
+		return models.DefaultLocale
+	}
+	return requested
+}
 
-	for _, food := range candidates {
-		weight := 1.0
-		if lastSeen, ok := historyMap[food.ID]; ok {
-			hoursSinceSeen := now.Sub(lastSeen).Hours()
-			if hoursSinceSeen < 1 {
-				weight = 0.01
-			} else if hoursSinceSeen < 6 {
-				weight = 0.1
-			} else if hoursSinceSeen < 18 {
-				weight = 0.4
-			} else {
-				weight = 0.8
-			}
+func validateTranslations(translations map[string]models.LocalizedText) error {
+	for locale, t := range translations {
+		if locale == models.DefaultLocale || !models.IsSupportedLocale(locale) {
+			return apperr.BadRequest("unsupported translation locale: "+locale, nil)
+		}
+		if strings.TrimSpace(t.Name) == "" {
+			return apperr.BadRequest("translated name cannot be empty: "+locale, nil)
 		}
-
-		scoredList = append(scoredList, scoredFood{
-			food:  food,
-			score: weight * (0.8 + rand.Float64()*0.4),
-		})
 	}
+	return nil
+}
 
-	sort.Slice(scoredList, func(i, j int) bool {
-		return scoredList[i].score > scoredList[j].score
-	})
+func applyDietaryProfile(filter repositories.StandardFoodFilter, profile models.DietaryProfile) repositories.StandardFoodFilter {
+	filter.ExcludeAllergens = profile.Allergens
+	filter.RequireDiets = profile.Diets
+	return filter
+}
 
-	var finalFoods []models.StandardFood
+// 요청한 개수를 못 채웠을 때, 식단 프로필 조건이 없었다면 더 채울 수 있었는지 확인
+func (s *foodService) buildFeedMeta(ctx context.Context, filter repositories.StandardFoodFilter, requested, returned int) models.FeedMeta {
+	meta := models.FeedMeta{
+		Requested: requested,
+		Returned:  returned,
+	}
+	if returned >= requested || (len(filter.ExcludeAllergens) == 0 && len(filter.RequireDiets) == 0) {
+		return meta
+	}
 
-	excludedParents, err := s.recHistoryRepo.GetLatestParents(ctx, userID, 7)
+	total, err := s.foodRepo.CountStandards(ctx, filter.WithoutProfile())
 	if err != nil {
-		return nil, apperr.InternalServerError("failed to get latest parents from history", err)
+		log.Printf("[WARNING] failed to count foods without dietary profile: %v", err)
+		return meta
 	}
+	meta.LimitedByProfile = total > int64(returned)
+	return meta
+}
 
-	usedParents := make(map[string]bool)
-	for _, parent := range excludedParents {
-		usedParents[parent] = true
+func (s *foodService) getRecommendedFoods(ctx context.Context, req RecommendRequest) ([]models.StandardFood, primitive.ObjectID, error) {
+	recs, historyID, err := s.recommendAndRecord(ctx, s.recommender, nil, req)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
 	}
 
-	for _, sf := range scoredList {
-		if len(finalFoods) >= count {
-			break
-		}
+	foods := make([]models.StandardFood, 0, len(recs))
+	for _, rec := range recs {
+		foods = append(foods, rec.Food)
+	}
+	return foods, historyID, nil
+}
+
+// 메인 피드는 실행 중인 실험이 있으면 사용자에게 배정된 변형의 전략을 사용하고, 추천 이유도 함께 돌려줌
+func (s *foodService) getFeedRecommendedFoods(ctx context.Context, req RecommendRequest) ([]Recommendation, primitive.ObjectID, error) {
+	rec, assignment := s.feedRecommender(ctx, req.UserID)
+	return s.recommendAndRecord(ctx, rec, assignment, req)
+}
+
+func (s *foodService) feedRecommender(ctx context.Context, userID primitive.ObjectID) (Recommender, *models.ExperimentAssignment) {
+	s.cacheLock.RLock()
+	cache := s.experiments
+	s.cacheLock.RUnlock()
+
+	if time.Since(cache.loadedAt) > experimentCacheTTL {
+		cache = s.reloadExperiments(ctx)
+	}
+	if cache.experiment == nil {
+		return s.recommender, nil
+	}
+
+	variant := assignVariant(cache.experiment, userID)
+	if variant == nil {
+		return s.recommender, nil
+	}
+	return cache.recommenders[variant.Name], &models.ExperimentAssignment{
+		Experiment: cache.experiment.Name,
+		Variant:    variant.Name,
+	}
+}
+
+// 실패하면 실험 없이 기본 전략으로 추천하고, 다음 주기에 다시 시도
+func (s *foodService) reloadExperiments(ctx context.Context) experimentCache {
+	cache := experimentCache{loadedAt: time.Now()}
 
-		isOverlap := false
-		for _, parent := range sf.food.Parents {
-			if usedParents[parent] {
-				isOverlap = true
+	experiment, err := s.experimentRepo.FindRunning(ctx)
+	if err != nil {
+		log.Printf("[WARNING] failed to load running experiment: %v", err)
+	} else if experiment != nil {
+		recommenders := make(map[string]Recommender, len(experiment.Variants))
+		for _, variant := range experiment.Variants {
+			rec, err := s.newRecommender(applyRecommenderParams(s.recConfig, variant.Params))
+			if err != nil {
+				log.Printf("[WARNING] skipping experiment %s: %v", experiment.Name, err)
+				recommenders = nil
 				break
 			}
+			recommenders[variant.Name] = rec
 		}
-
-		if !isOverlap {
-			finalFoods = append(finalFoods, sf.food)
-			for _, parent := range sf.food.Parents {
-				usedParents[parent] = true
-			}
+		if recommenders != nil {
+			cache.experiment = experiment
+			cache.recommenders = recommenders
 		}
 	}
 
-	// 혹시라도 부족할 시 부모 상관없이 채우기
-	if len(finalFoods) < count {
-		for _, sf := range scoredList {
-			if len(finalFoods) >= count {
-				break
-			}
+	s.cacheLock.Lock()
+	s.experiments = cache
+	s.cacheLock.Unlock()
+	return cache
+}
 
-			alreadyAdded := false
-			for _, f := range finalFoods {
-				if f.ID == sf.food.ID {
-					alreadyAdded = true
-					break
-				}
-			}
+// 추천 전략으로 음식을 고르고 추천 기록으로 남김. 반응 이벤트를 연결할 수 있도록 기록 ID를 반환
+func (s *foodService) recommendAndRecord(ctx context.Context, rec Recommender, assignment *models.ExperimentAssignment, req RecommendRequest) ([]Recommendation, primitive.ObjectID, error) {
+	filter, err := s.withHides(ctx, req.UserID, req.Filter)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+	req.Filter = filter
 
-			if !alreadyAdded {
-				finalFoods = append(finalFoods, sf.food)
-			}
-		}
+	recs, err := rec.Recommend(ctx, req)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
 	}
 
-	finalIDs := make([]primitive.ObjectID, 0, len(finalFoods))
+	finalIDs := make([]primitive.ObjectID, 0, len(recs))
 	finalParents := make([]string, 0)
-	for _, food := range finalFoods {
-		finalIDs = append(finalIDs, food.ID)
-		finalParents = append(finalParents, food.Parents...)
+	for _, r := range recs {
+		finalIDs = append(finalIDs, r.Food.ID)
+		finalParents = append(finalParents, r.Food.Parents...)
 	}
 	newHistory := models.RecHistory{
 		ID:        primitive.NewObjectID(),
-		UserID:    userID,
+		UserID:    req.UserID,
 		FoodIDs:   finalIDs,
 		Parents:   finalParents,
-		CreatedAt: now,
+		CreatedAt: time.Now(),
+	}
+	if assignment != nil {
+		newHistory.Experiment = assignment.Experiment
+		newHistory.Variant = assignment.Variant
 	}
 
 	go func(h models.RecHistory) {
@@ -305,24 +622,54 @@ func (s *foodService) getRecommendedFoods(ctx context.Context, userID primitive.
 		if err := s.recHistoryRepo.SaveHistory(bgCtx, h); err != nil {
 			log.Printf("[WARNING] failed to save recommendation history: %v", err)
 		}
+		if assignment != nil {
+			exposure := models.ExperimentExposure{
+				ID:         h.ID,
+				Experiment: assignment.Experiment,
+				Variant:    assignment.Variant,
+				UserID:     h.UserID,
+				FoodIDs:    h.FoodIDs,
+				CreatedAt:  h.CreatedAt,
+			}
+			if err := s.experimentRepo.SaveExposure(bgCtx, exposure); err != nil {
+				log.Printf("[WARNING] failed to save experiment exposure: %v", err)
+			}
+		}
+		if err := s.recEventRepo.IncrementImpressions(bgCtx, h.UserID, h.FoodIDs, h.CreatedAt); err != nil {
+			log.Printf("[WARNING] failed to count recommendation impressions: %v", err)
+		}
 	}(newHistory)
 
-	return finalFoods, nil
+	return recs, newHistory.ID, nil
+}
+
+// 사용자가 숨긴 음식과 부모를 필터에서 제외
+func (s *foodService) withHides(ctx context.Context, userID primitive.ObjectID, filter repositories.StandardFoodFilter) (repositories.StandardFoodFilter, error) {
+	hides, err := s.hideRepo.FindActiveByUserID(ctx, userID, time.Now())
+	if err != nil {
+		return filter, apperr.InternalServerError("failed to fetch hides", err)
+	}
+	return applyHides(filter, hides), nil
 }
 
-func (s *foodService) wrapWithLikeStatus(ctx context.Context, userID primitive.ObjectID, foods []models.StandardFood) ([]models.FoodLikeResponse, error) {
+func (s *foodService) wrapWithLikeStatus(ctx context.Context, userID primitive.ObjectID, foods []models.StandardFood, locale string) ([]models.FoodLikeResponse, error) {
+	return wrapFoodsWithLikeStatus(ctx, s.likeRepo, userID, foods, locale)
+}
+
+func wrapFoodsWithLikeStatus(ctx context.Context, likeRepo repositories.LikeRepository, userID primitive.ObjectID, foods []models.StandardFood, locale string) ([]models.FoodLikeResponse, error) {
 	foodIDs := make([]primitive.ObjectID, 0, len(foods))
 	for _, food := range foods {
 		foodIDs = append(foodIDs, food.ID)
 	}
 
-	likedMap, err := s.likeRepo.CheckLikedStatus(ctx, userID, foodIDs)
+	likedMap, err := likeRepo.CheckLikedStatus(ctx, userID, foodIDs)
 	if err != nil {
 		return nil, apperr.InternalServerError("failed to check liked status", err)
 	}
 
 	var responses []models.FoodLikeResponse
 	for _, food := range foods {
+		food.Localize(locale)
 		responses = append(responses, models.FoodLikeResponse{
 			Food:    food,
 			IsLiked: likedMap[food.ID],
@@ -332,7 +679,7 @@ func (s *foodService) wrapWithLikeStatus(ctx context.Context, userID primitive.O
 	return responses, nil
 }
 
-func (s *foodService) GetMainFeedFoods(ctx context.Context, userID string, speed string, count int) ([]models.FoodLikeResponse, error) {
+func (s *foodService) GetMainFeedFoods(ctx context.Context, userID string, speed string, count int, mealTime string, timezone string, locale string) (*models.FoodFeedResult, error) {
 	uID, err := primitive.ObjectIDFromHex(userID)
 	if err != nil {
 		return nil, apperr.InternalServerError("invalid user ID in token", err)
@@ -346,15 +693,211 @@ func (s *foodService) GetMainFeedFoods(ctx context.Context, userID string, speed
 		return nil, apperr.BadRequest("invalid speed type", nil)
 	}
 
-	foods, err := s.getRecommendedFoods(ctx, uID, speed, count)
+	mealTime, err = resolveMealTime(mealTime, timezone, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.getUser(ctx, uID)
+	if err != nil {
+		return nil, err
+	}
+	filter := applyDietaryProfile(repositories.StandardFoodFilter{Speed: speed}, user.DietaryProfile)
+
+	recs, historyID, err := s.getFeedRecommendedFoods(ctx, RecommendRequest{UserID: uID, Filter: filter, Count: count, MealTime: mealTime})
+	if err != nil {
+		return nil, err
+	}
+
+	foods := make([]models.StandardFood, 0, len(recs))
+	for _, rec := range recs {
+		foods = append(foods, rec.Food)
+	}
+	responses, err := s.wrapWithLikeStatus(ctx, uID, foods, preferredLocale(user, locale))
 	if err != nil {
 		return nil, err
 	}
+	for i := range responses {
+		responses[i].Reasons = recs[i].Reasons
+	}
 
-	return s.wrapWithLikeStatus(ctx, uID, foods)
+	meta := s.buildFeedMeta(ctx, filter, count, len(responses))
+	meta.RecommendationID = historyID.Hex()
+	meta.MealTime = mealTime
+
+	return &models.FoodFeedResult{
+		Foods: responses,
+		Meta:  meta,
+	}, nil
 }
 
-func (s *foodService) GetFoodsByCategories(ctx context.Context, userID string, speed string, categories []string, count int) ([]models.FoodLikeResponse, error) {
+// 함께 먹는 모두의 식단 조건과 숨김을 지키고, 누구에게든 최근 추천됐거나 최근 먹은 음식은 제외.
+// 멤버는 모두 모임에 직접 들어온 사용자이므로, 추천 기록을 멤버마다 남겨 각자의 피드에서도 반복되지 않게 함
+func (s *foodService) GetGroupFeedFoods(ctx context.Context, userID string, query models.GroupFeedQuery, locale string) (*models.FoodFeedResult, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	if query.Count <= 0 || query.Count > 10 {
+		return nil, apperr.BadRequest("invalid food count", nil)
+	}
+
+	if query.Speed != models.SpeedFast && query.Speed != models.SpeedSlow {
+		return nil, apperr.BadRequest("invalid speed type", nil)
+	}
+
+	mealTime, err := resolveMealTime(query.MealTime, query.Timezone, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := s.resolveGroupMembers(ctx, uID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.userRepo.FindByIDs(ctx, memberIDs)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch group members", err)
+	}
+	if len(users) != len(memberIDs) {
+		return nil, apperr.NotFound("some group members not found", nil)
+	}
+
+	var requester *models.User
+	profiles := make([]models.DietaryProfile, 0, len(users))
+	for _, user := range users {
+		profiles = append(profiles, user.DietaryProfile)
+		if user.ID == uID {
+			requester = user
+		}
+	}
+	filter := applyDietaryProfile(repositories.StandardFoodFilter{Speed: query.Speed}, models.MergeDietaryProfiles(profiles))
+
+	hides, err := s.hideRepo.FindActiveByUserIDs(ctx, memberIDs, time.Now())
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch hides", err)
+	}
+	filter = applyHides(filter, hides)
+
+	recs, err := s.recommender.RecommendGroup(ctx, GroupRecommendRequest{
+		UserIDs:  memberIDs,
+		Filter:   filter,
+		Count:    query.Count,
+		MealTime: mealTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foods := make([]models.StandardFood, 0, len(recs))
+	foodIDs := make([]primitive.ObjectID, 0, len(recs))
+	parents := make([]string, 0)
+	for _, rec := range recs {
+		foods = append(foods, rec.Food)
+		foodIDs = append(foodIDs, rec.Food.ID)
+		parents = append(parents, rec.Food.Parents...)
+	}
+
+	now := time.Now()
+	histories := make([]models.RecHistory, 0, len(memberIDs))
+	var requesterHistoryID primitive.ObjectID
+	for _, memberID := range memberIDs {
+		history := models.RecHistory{
+			ID:        primitive.NewObjectID(),
+			UserID:    memberID,
+			FoodIDs:   foodIDs,
+			Parents:   parents,
+			CreatedAt: now,
+		}
+		if memberID == uID {
+			requesterHistoryID = history.ID
+		}
+		histories = append(histories, history)
+	}
+
+	// 노출은 실제로 화면을 본 요청자에게만 셈
+	go func(hs []models.RecHistory) {
+		bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		for _, h := range hs {
+			if err := s.recHistoryRepo.SaveHistory(bgCtx, h); err != nil {
+				log.Printf("[WARNING] failed to save group recommendation history: %v", err)
+			}
+		}
+		if err := s.recEventRepo.IncrementImpressions(bgCtx, uID, foodIDs, now); err != nil {
+			log.Printf("[WARNING] failed to count recommendation impressions: %v", err)
+		}
+	}(histories)
+
+	responses, err := s.wrapWithLikeStatus(ctx, uID, foods, preferredLocale(requester, locale))
+	if err != nil {
+		return nil, err
+	}
+	for i := range responses {
+		responses[i].Reasons = recs[i].Reasons
+	}
+
+	meta := s.buildFeedMeta(ctx, filter, query.Count, len(responses))
+	meta.RecommendationID = requesterHistoryID.Hex()
+	meta.MealTime = mealTime
+
+	return &models.FoodFeedResult{
+		Foods: responses,
+		Meta:  meta,
+	}, nil
+}
+
+// 공유 코드가 있으면 그 모임의 멤버, 없으면 요청자와 주어진 사용자들.
+// 다른 사용자의 식단 조건이 드러나거나 추천 기록이 남지 않도록, 주어진 사용자들은 요청자와 같은 모임에 들어와 있어야 함
+func (s *foodService) resolveGroupMembers(ctx context.Context, userID primitive.ObjectID, query models.GroupFeedQuery) ([]primitive.ObjectID, error) {
+	if query.Code != "" && len(query.UserIDs) > 0 {
+		return nil, apperr.BadRequest("use either a share code or user IDs, not both", nil)
+	}
+
+	var memberIDs []primitive.ObjectID
+	if query.Code != "" {
+		group, err := findMealGroupForMember(ctx, s.mealGroupRepo, userID, query.Code)
+		if err != nil {
+			return nil, err
+		}
+		memberIDs = group.MemberIDs
+	} else {
+		seen := map[primitive.ObjectID]bool{userID: true}
+		memberIDs = []primitive.ObjectID{userID}
+		for _, idStr := range query.UserIDs {
+			id, err := primitive.ObjectIDFromHex(idStr)
+			if err != nil {
+				return nil, apperr.BadRequest("invalid user ID format", err)
+			}
+			if !seen[id] {
+				seen[id] = true
+				memberIDs = append(memberIDs, id)
+			}
+		}
+	}
+
+	if len(memberIDs) < 2 {
+		return nil, apperr.BadRequest("a group needs at least two members", nil)
+	}
+	if len(memberIDs) > models.MealGroupMaxMembers {
+		return nil, apperr.BadRequest("too many group members", nil)
+	}
+
+	if query.Code == "" {
+		joined, err := s.mealGroupRepo.ExistsActiveWithMembers(ctx, memberIDs, time.Now())
+		if err != nil {
+			return nil, apperr.InternalServerError("failed to fetch meal group", err)
+		}
+		if !joined {
+			return nil, apperr.Forbidden("all users must be members of an active meal group you are in", nil)
+		}
+	}
+	return memberIDs, nil
+}
+
+func (s *foodService) GetFoodsByCategories(ctx context.Context, userID string, speed string, categories []string, count int, locale string) (*models.FoodFeedResult, error) {
 	uID, err := primitive.ObjectIDFromHex(userID)
 	if err != nil {
 		return nil, apperr.InternalServerError("invalid user ID in token", err)
@@ -368,12 +911,437 @@ func (s *foodService) GetFoodsByCategories(ctx context.Context, userID string, s
 		return nil, apperr.BadRequest("invalid speed type", nil)
 	}
 
-	foods, err := s.foodRepo.GetRandomStandards(ctx, speed, categories, count)
+	if len(categories) == 0 {
+		return nil, apperr.BadRequest("at least one category is required", nil)
+	}
+
+	allCategories, err := s.categoryRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch categories", err)
+	}
+	if unknown := findUnknownCategories(allCategories, categories); len(unknown) > 0 {
+		return nil, apperr.BadRequest("unknown categories: "+strings.Join(unknown, ", "), nil)
+	}
+
+	// 상위 카테고리를 고르면 하위 카테고리의 음식까지 포함
+	categoryKeys := expandCategoryKeys(allCategories, categories)
+
+	user, err := s.getUser(ctx, uID)
+	if err != nil {
+		return nil, err
+	}
+	filter := applyDietaryProfile(repositories.StandardFoodFilter{Speed: speed, Categories: categoryKeys}, user.DietaryProfile)
+	filter, err = s.withHides(ctx, uID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	foods, err := s.foodRepo.GetRandomStandards(ctx, filter, count)
 	if err != nil {
 		return nil, apperr.InternalServerError("failed to get foods by categories", err)
 	}
 
-	return s.wrapWithLikeStatus(ctx, uID, foods)
+	responses, err := s.wrapWithLikeStatus(ctx, uID, foods, preferredLocale(user, locale))
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.FoodFeedResult{
+		Foods: responses,
+		Meta:  s.buildFeedMeta(ctx, filter, count, len(responses)),
+	}, nil
+}
+
+func validateAttributes(attributes map[string]string) error {
+	for key, value := range attributes {
+		if !models.IsValidAttribute(key, value) {
+			return apperr.BadRequest("invalid attribute: "+key+"="+value, nil)
+		}
+	}
+	return nil
+}
+
+func (s *foodService) BrowseFoods(ctx context.Context, userID string, query models.BrowseFoodsQuery) (*models.BrowseFoodsResponse, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	if query.Count <= 0 || query.Count > 30 {
+		return nil, apperr.BadRequest("invalid food count", nil)
+	}
+
+	if query.Speed != "" && query.Speed != models.SpeedFast && query.Speed != models.SpeedSlow {
+		return nil, apperr.BadRequest("invalid speed type", nil)
+	}
+
+	for key, values := range query.Attributes {
+		for _, value := range values {
+			if !models.IsValidAttribute(key, value) {
+				return nil, apperr.BadRequest("invalid attribute filter: "+key+"="+value, nil)
+			}
+		}
+	}
+
+	var afterID primitive.ObjectID
+	if query.Cursor != "" {
+		afterID, err = primitive.ObjectIDFromHex(query.Cursor)
+		if err != nil {
+			return nil, apperr.BadRequest("invalid cursor", err)
+		}
+	}
+
+	user, err := s.getUser(ctx, uID)
+	if err != nil {
+		return nil, err
+	}
+	filter := applyDietaryProfile(repositories.StandardFoodFilter{
+		Speed:      query.Speed,
+		Attributes: query.Attributes,
+	}, user.DietaryProfile)
+
+	// 다음 페이지 존재 여부 확인을 위해 하나 더 조회
+	foods, facets, err := s.foodRepo.BrowseStandards(ctx, filter, models.FoodAttributeKeys, afterID, query.Count+1)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to browse foods", err)
+	}
+
+	nextCursor := ""
+	if len(foods) > query.Count {
+		foods = foods[:query.Count]
+		nextCursor = foods[len(foods)-1].ID.Hex()
+	}
+
+	responses, err := s.wrapWithLikeStatus(ctx, uID, foods, preferredLocale(user, query.Locale))
+	if err != nil {
+		return nil, err
+	}
+	if responses == nil {
+		responses = []models.FoodLikeResponse{}
+	}
+
+	return &models.BrowseFoodsResponse{
+		Foods:      responses,
+		Facets:     facets,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *foodService) SearchFoods(ctx context.Context, userID string, query string, count int, locale string) ([]models.FoodLikeResponse, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, apperr.BadRequest("search query cannot be empty", nil)
+	}
+
+	if count <= 0 || count > 30 {
+		return nil, apperr.BadRequest("invalid food count", nil)
+	}
+
+	user, err := s.getUser(ctx, uID)
+	if err != nil {
+		return nil, err
+	}
+	filter := applyDietaryProfile(repositories.StandardFoodFilter{}, user.DietaryProfile)
+
+	foods, err := s.foodRepo.SearchStandards(ctx, query, filter, count)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to search foods", err)
+	}
+
+	responses, err := s.wrapWithLikeStatus(ctx, uID, foods, preferredLocale(user, locale))
+	if err != nil {
+		return nil, err
+	}
+	if responses == nil {
+		responses = []models.FoodLikeResponse{}
+	}
+
+	return responses, nil
+}
+
+func (s *foodService) fetchReviewSnippets(ctx context.Context, foodID primitive.ObjectID, beforeID primitive.ObjectID, count int) (*models.ReviewSnippetPage, error) {
+	// 다음 페이지 존재 여부 확인을 위해 하나 더 조회
+	snippets, err := s.reviewRepo.FindSnippetsByFoodItem(ctx, foodID.Hex(), models.FoodTypeStandard, beforeID, count+1)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch review snippets", err)
+	}
+
+	page := &models.ReviewSnippetPage{Reviews: snippets}
+	if len(snippets) > count {
+		page.Reviews = snippets[:count]
+		page.NextCursor = page.Reviews[count-1].ID.Hex()
+	}
+	return page, nil
+}
+
+func (s *foodService) GetFoodDetail(ctx context.Context, userID string, foodID string, reviewCount int, locale string) (*models.FoodDetailResponse, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	if reviewCount <= 0 || reviewCount > 20 {
+		return nil, apperr.BadRequest("invalid review count", nil)
+	}
+
+	user, err := s.getUser(ctx, uID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 병합된 예전 ID도 GetStandardByID에서 현재 음식으로 연결됨
+	food, err := s.GetStandardByID(ctx, foodID, preferredLocale(user, locale))
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.reviewRepo.AggregateFoodReviewStats(ctx, food.ID.Hex(), models.FoodTypeStandard)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to aggregate review stats", err)
+	}
+
+	likedMap, err := s.likeRepo.CheckLikedStatus(ctx, uID, []primitive.ObjectID{food.ID})
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to check liked status", err)
+	}
+
+	page, err := s.fetchReviewSnippets(ctx, food.ID, primitive.NilObjectID, reviewCount)
+	if err != nil {
+		return nil, err
+	}
+
+	averageRating := 0.0
+	if stats.ReviewCount > 0 {
+		averageRating = math.Round(float64(stats.TotalRating)/float64(stats.ReviewCount)*100) / 100
+	}
+
+	return &models.FoodDetailResponse{
+		Food:              *food,
+		IsLiked:           likedMap[food.ID],
+		LikeCount:         food.LikeCount,
+		ReviewCount:       stats.ReviewCount,
+		AverageRating:     averageRating,
+		RatingHistogram:   stats.RatingHistogram,
+		MealTimeBreakdown: stats.MealTimeBreakdown,
+		Reviews:           page.Reviews,
+		NextCursor:        page.NextCursor,
+	}, nil
+}
+
+func (s *foodService) GetFoodReviews(ctx context.Context, foodID string, cursor string, count int) (*models.ReviewSnippetPage, error) {
+	if count <= 0 || count > 20 {
+		return nil, apperr.BadRequest("invalid review count", nil)
+	}
+
+	var beforeID primitive.ObjectID
+	if cursor != "" {
+		var err error
+		beforeID, err = primitive.ObjectIDFromHex(cursor)
+		if err != nil {
+			return nil, apperr.BadRequest("invalid cursor", err)
+		}
+	}
+
+	food, err := s.GetStandardByID(ctx, foodID, models.DefaultLocale)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fetchReviewSnippets(ctx, food.ID, beforeID, count)
+}
+
+// 공유하는 부모, 카테고리, 속성 값과 같은 리뷰에 함께 기록된 횟수로 유사도를 계산
+func similarityScore(base *models.StandardFood, food models.StandardFood, coOccurrence int) float64 {
+	score := 0.0
+	for _, p := range food.Parents {
+		for _, bp := range base.Parents {
+			if p == bp {
+				score += 3
+			}
+		}
+	}
+	for _, c := range food.Categories {
+		for _, bc := range base.Categories {
+			if c == bc {
+				score += 2
+			}
+		}
+	}
+	for key, value := range base.Attributes {
+		if food.Attributes[key] == value {
+			score += 0.5
+		}
+	}
+	// 함께 먹은 횟수는 많아질수록 영향이 완만해지도록 로그 스케일
+	score += 2 * math.Log1p(float64(coOccurrence))
+	return score
+}
+
+func (s *foodService) GetSimilarFoods(ctx context.Context, userID string, foodID string, speed string, count int, locale string) ([]models.FoodLikeResponse, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	if count <= 0 || count > 20 {
+		return nil, apperr.BadRequest("invalid food count", nil)
+	}
+
+	if speed != "" && speed != models.SpeedFast && speed != models.SpeedSlow {
+		return nil, apperr.BadRequest("invalid speed type", nil)
+	}
+
+	user, err := s.getUser(ctx, uID)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := s.GetStandardByID(ctx, foodID, models.DefaultLocale)
+	if err != nil {
+		return nil, err
+	}
+
+	coFoods, err := s.reviewRepo.AggregateCoOccurringStandards(ctx, base.ID.Hex(), 50)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to aggregate co-occurring foods", err)
+	}
+	coCounts := make(map[primitive.ObjectID]int, len(coFoods))
+	coIDs := make([]primitive.ObjectID, 0, len(coFoods))
+	for _, co := range coFoods {
+		coCounts[co.FoodID] = co.Count
+		coIDs = append(coIDs, co.FoodID)
+	}
+
+	filter := applyDietaryProfile(repositories.StandardFoodFilter{Speed: speed}, user.DietaryProfile)
+	candidates, err := s.foodRepo.FindSimilarCandidates(ctx, base, coIDs, filter, 300)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch similar food candidates", err)
+	}
+
+	scores := make(map[primitive.ObjectID]float64, len(candidates))
+	for _, food := range candidates {
+		scores[food.ID] = similarityScore(base, food, coCounts[food.ID])
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[candidates[i].ID] > scores[candidates[j].ID]
+	})
+	if len(candidates) > count {
+		candidates = candidates[:count]
+	}
+
+	responses, err := s.wrapWithLikeStatus(ctx, uID, candidates, preferredLocale(user, locale))
+	if err != nil {
+		return nil, err
+	}
+	if responses == nil {
+		responses = []models.FoodLikeResponse{}
+	}
+
+	return responses, nil
+}
+
+// 재료 이름은 공백 제거 후 소문자로 맞추고 중복을 없앰
+func normalizeIngredients(ingredients []string) ([]string, error) {
+	seen := make(map[string]bool, len(ingredients))
+	result := make([]string, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		normalized := utils.NormalizeFoodName(ingredient)
+		if normalized == "" {
+			return nil, apperr.BadRequest("ingredient name cannot be empty", nil)
+		}
+		if seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		result = append(result, normalized)
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+func ingredientCoverage(food models.StandardFood, have map[string]bool) (float64, []string) {
+	if len(food.Ingredients) == 0 {
+		return 0, []string{}
+	}
+	missing := []string{}
+	for _, ingredient := range food.Ingredients {
+		if !have[ingredient] {
+			missing = append(missing, ingredient)
+		}
+	}
+	coverage := float64(len(food.Ingredients)-len(missing)) / float64(len(food.Ingredients))
+	return math.Round(coverage*100) / 100, missing
+}
+
+// 집에서 요리하는 경우라 speed는 slow로 고정하며, 재료 충족률을 기본 점수로 두고 메인 피드와 같은 감쇠와 부모 다양성을 적용
+func (s *foodService) GetFridgeFoods(ctx context.Context, userID string, ingredients []string, count int, locale string) ([]models.FridgeFood, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	if count <= 0 || count > 10 {
+		return nil, apperr.BadRequest("invalid food count", nil)
+	}
+
+	normalized, err := normalizeIngredients(ingredients)
+	if err != nil {
+		return nil, err
+	}
+	if len(normalized) == 0 {
+		return nil, apperr.BadRequest("at least one ingredient is required", nil)
+	}
+	have := make(map[string]bool, len(normalized))
+	for _, ingredient := range normalized {
+		have[ingredient] = true
+	}
+
+	user, err := s.getUser(ctx, uID)
+	if err != nil {
+		return nil, err
+	}
+	filter := applyDietaryProfile(repositories.StandardFoodFilter{Speed: models.SpeedSlow}, user.DietaryProfile)
+
+	foods, _, err := s.getRecommendedFoods(ctx, RecommendRequest{
+		UserID: uID,
+		Filter: filter,
+		Count:  count,
+		Source: func(ctx context.Context, limit int) ([]models.StandardFood, error) {
+			return s.foodRepo.FindStandardsByIngredients(ctx, normalized, filter, limit)
+		},
+		BaseScore: func(food models.StandardFood) float64 {
+			coverage, _ := ingredientCoverage(food, have)
+			// 충족률 차이가 랜덤 가중치에 묻히지 않도록 제곱
+			return coverage * coverage
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := s.wrapWithLikeStatus(ctx, uID, foods, preferredLocale(user, locale))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.FridgeFood, 0, len(responses))
+	for i, response := range responses {
+		coverage, missing := ingredientCoverage(foods[i], have)
+		result = append(result, models.FridgeFood{
+			FoodLikeResponse:   response,
+			Coverage:           coverage,
+			MissingIngredients: missing,
+		})
+	}
+
+	return result, nil
 }
 
 func (s *foodService) IncrementLikeCount(ctx context.Context, foodID string) error {
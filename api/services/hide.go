@@ -0,0 +1,168 @@
+// api/services/hide.go
+
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type HideService interface {
+	HideFood(ctx context.Context, userID string, foodID string, req models.CreateHideRequest) ([]models.Hide, error)
+	GetHides(ctx context.Context, userID string) ([]models.HideResponse, error)
+	Unhide(ctx context.Context, userID string, hideID string) error
+}
+
+type hideService struct {
+	hideRepo repositories.HideRepository
+	foodRepo repositories.FoodRepository
+}
+
+func NewHideService(hr repositories.HideRepository, fr repositories.FoodRepository) HideService {
+	return &hideService{
+		hideRepo: hr,
+		foodRepo: fr,
+	}
+}
+
+// parent 숨김은 음식의 부모마다 하나씩 만들어짐
+func (s *hideService) HideFood(ctx context.Context, userID string, foodID string, req models.CreateHideRequest) ([]models.Hide, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	fID, err := primitive.ObjectIDFromHex(foodID)
+	if err != nil {
+		return nil, apperr.BadRequest("invalid food ID format", err)
+	}
+
+	if req.Scope != models.HideScopeFood && req.Scope != models.HideScopeParent {
+		return nil, apperr.BadRequest("invalid hide scope", nil)
+	}
+
+	now := time.Now()
+	var expiresAt *time.Time
+	switch req.Duration {
+	case models.HideDurationWeek:
+		t := now.Add(models.HideWeek)
+		expiresAt = &t
+	case models.HideDurationForever:
+	default:
+		return nil, apperr.BadRequest("invalid hide duration", nil)
+	}
+
+	food, err := s.foodRepo.FindStandardByID(ctx, fID)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch food", err)
+	}
+	if food == nil {
+		return nil, apperr.NotFound("food not found", nil)
+	}
+
+	var hides []*models.Hide
+	if req.Scope == models.HideScopeFood {
+		hides = append(hides, &models.Hide{Scope: models.HideScopeFood, FoodID: &fID})
+	} else {
+		if len(food.Parents) == 0 {
+			return nil, apperr.BadRequest("food has no parent to hide", nil)
+		}
+		for _, parent := range food.Parents {
+			hides = append(hides, &models.Hide{Scope: models.HideScopeParent, Parent: parent})
+		}
+	}
+
+	saved := make([]models.Hide, 0, len(hides))
+	for _, hide := range hides {
+		hide.ID = primitive.NewObjectID()
+		hide.UserID = uID
+		hide.ExpiresAt = expiresAt
+		hide.CreatedAt = now
+
+		if err := s.hideRepo.Upsert(ctx, hide); err != nil {
+			return nil, apperr.InternalServerError("failed to save hide", err)
+		}
+		saved = append(saved, *hide)
+	}
+
+	return saved, nil
+}
+
+func (s *hideService) GetHides(ctx context.Context, userID string) ([]models.HideResponse, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	hides, err := s.hideRepo.FindActiveByUserID(ctx, uID, time.Now())
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch hides", err)
+	}
+
+	var foodIDs []primitive.ObjectID
+	for _, hide := range hides {
+		if hide.FoodID != nil {
+			foodIDs = append(foodIDs, *hide.FoodID)
+		}
+	}
+
+	foodMap := make(map[primitive.ObjectID]*models.StandardFood)
+	if len(foodIDs) > 0 {
+		foods, err := s.foodRepo.FindStandardByIDs(ctx, foodIDs)
+		if err != nil {
+			return nil, apperr.InternalServerError("failed to fetch hidden foods", err)
+		}
+		for _, food := range foods {
+			foodMap[food.ID] = food
+		}
+	}
+
+	responses := make([]models.HideResponse, 0, len(hides))
+	for _, hide := range hides {
+		response := models.HideResponse{Hide: hide}
+		if hide.FoodID != nil {
+			response.Food = foodMap[*hide.FoodID]
+		}
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
+
+func (s *hideService) Unhide(ctx context.Context, userID string, hideID string) error {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	hID, err := primitive.ObjectIDFromHex(hideID)
+	if err != nil {
+		return apperr.BadRequest("invalid hide ID format", err)
+	}
+
+	deletedCount, err := s.hideRepo.Delete(ctx, uID, hID)
+	if err != nil {
+		return apperr.InternalServerError("failed to delete hide", err)
+	}
+	if deletedCount == 0 {
+		return apperr.NotFound("hide not found", nil)
+	}
+
+	return nil
+}
+
+func applyHides(filter repositories.StandardFoodFilter, hides []models.Hide) repositories.StandardFoodFilter {
+	for _, hide := range hides {
+		if hide.FoodID != nil {
+			filter.ExcludeIDs = append(filter.ExcludeIDs, *hide.FoodID)
+		} else if hide.Parent != "" {
+			filter.ExcludeParents = append(filter.ExcludeParents, hide.Parent)
+		}
+	}
+	return filter
+}
@@ -20,14 +20,16 @@ type LikeService interface {
 }
 
 type likeService struct {
-	likeRepo repositories.LikeRepository
-	foodRepo repositories.FoodRepository
+	likeRepo      repositories.LikeRepository
+	foodRepo      repositories.FoodRepository
+	tasteProfiler TasteProfiler
 }
 
-func NewLikeService(likeRepo repositories.LikeRepository, foodRepo repositories.FoodRepository) LikeService {
+func NewLikeService(likeRepo repositories.LikeRepository, foodRepo repositories.FoodRepository, tasteProfiler TasteProfiler) LikeService {
 	return &likeService{
-		likeRepo: likeRepo,
-		foodRepo: foodRepo,
+		likeRepo:      likeRepo,
+		foodRepo:      foodRepo,
+		tasteProfiler: tasteProfiler,
 	}
 }
 
@@ -59,6 +61,8 @@ func (s *likeService) LikeFood(ctx context.Context, userID, foodID string) error
 		return apperr.InternalServerError("failed to increment like count", err)
 	}
 
+	s.tasteProfiler.RefreshAsync(uID)
+
 	return nil
 }
 
@@ -86,6 +90,8 @@ func (s *likeService) UnlikeFood(ctx context.Context, userID, foodID string) err
 		return apperr.InternalServerError("failed to decrement like count", err)
 	}
 
+	s.tasteProfiler.RefreshAsync(uID)
+
 	return nil
 }
 
@@ -0,0 +1,175 @@
+// api/services/meal_group.go
+
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// 헷갈리기 쉬운 0/O, 1/I/L은 뺌
+const (
+	mealGroupCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+	mealGroupCodeLength   = 6
+)
+
+type MealGroupService interface {
+	CreateGroup(ctx context.Context, userID string) (*models.MealGroupResponse, error)
+	JoinGroup(ctx context.Context, userID string, code string) (*models.MealGroupResponse, error)
+	GetGroup(ctx context.Context, userID string, code string) (*models.MealGroupResponse, error)
+}
+
+type mealGroupService struct {
+	mealGroupRepo repositories.MealGroupRepository
+	userRepo      repositories.UserRepository
+}
+
+func NewMealGroupService(mgr repositories.MealGroupRepository, ur repositories.UserRepository) MealGroupService {
+	return &mealGroupService{
+		mealGroupRepo: mgr,
+		userRepo:      ur,
+	}
+}
+
+func (s *mealGroupService) CreateGroup(ctx context.Context, userID string) (*models.MealGroupResponse, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	now := time.Now()
+	group := &models.MealGroup{
+		ID:        primitive.NewObjectID(),
+		OwnerID:   uID,
+		MemberIDs: []primitive.ObjectID{uID},
+		ExpiresAt: now.Add(models.MealGroupTTL),
+		CreatedAt: now,
+	}
+
+	// 코드가 겹치면 몇 번 다시 만듦
+	for attempt := 0; ; attempt++ {
+		group.Code, err = generateMealGroupCode()
+		if err != nil {
+			return nil, apperr.InternalServerError("failed to generate share code", err)
+		}
+
+		err = s.mealGroupRepo.Create(ctx, group)
+		if err == nil {
+			break
+		}
+		if !mongo.IsDuplicateKeyError(err) || attempt >= 4 {
+			return nil, apperr.InternalServerError("failed to create meal group", err)
+		}
+	}
+
+	return s.buildResponse(ctx, group)
+}
+
+func (s *mealGroupService) JoinGroup(ctx context.Context, userID string, code string) (*models.MealGroupResponse, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	code = normalizeMealGroupCode(code)
+	now := time.Now()
+	group, err := s.mealGroupRepo.AddMember(ctx, code, uID, now)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to join meal group", err)
+	}
+	if group == nil {
+		existing, err := s.mealGroupRepo.FindActiveByCode(ctx, code, now)
+		if err != nil {
+			return nil, apperr.InternalServerError("failed to fetch meal group", err)
+		}
+		if existing == nil {
+			return nil, apperr.NotFound("meal group not found or expired", nil)
+		}
+		return nil, apperr.Conflict("meal group is full", nil)
+	}
+
+	return s.buildResponse(ctx, group)
+}
+
+func (s *mealGroupService) GetGroup(ctx context.Context, userID string, code string) (*models.MealGroupResponse, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	group, err := findMealGroupForMember(ctx, s.mealGroupRepo, uID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildResponse(ctx, group)
+}
+
+func (s *mealGroupService) buildResponse(ctx context.Context, group *models.MealGroup) (*models.MealGroupResponse, error) {
+	users, err := s.userRepo.FindByIDs(ctx, group.MemberIDs)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch group members", err)
+	}
+
+	usernames := make(map[primitive.ObjectID]string, len(users))
+	for _, user := range users {
+		usernames[user.ID] = user.Username
+	}
+
+	// 들어온 순서대로, 탈퇴한 사용자는 빼고 보여줌
+	members := make([]models.MealGroupMember, 0, len(group.MemberIDs))
+	for _, id := range group.MemberIDs {
+		if username, ok := usernames[id]; ok {
+			members = append(members, models.MealGroupMember{ID: id, Username: username})
+		}
+	}
+
+	return &models.MealGroupResponse{
+		MealGroup: *group,
+		Members:   members,
+	}, nil
+}
+
+// 멤버가 아니면 코드를 알아도 조회할 수 없음
+func findMealGroupForMember(ctx context.Context, repo repositories.MealGroupRepository, userID primitive.ObjectID, code string) (*models.MealGroup, error) {
+	group, err := repo.FindActiveByCode(ctx, normalizeMealGroupCode(code), time.Now())
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch meal group", err)
+	}
+	if group == nil {
+		return nil, apperr.NotFound("meal group not found or expired", nil)
+	}
+
+	for _, id := range group.MemberIDs {
+		if id == userID {
+			return group, nil
+		}
+	}
+	return nil, apperr.Forbidden("you are not a member of this meal group", nil)
+}
+
+func normalizeMealGroupCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func generateMealGroupCode() (string, error) {
+	code := make([]byte, mealGroupCodeLength)
+	max := big.NewInt(int64(len(mealGroupCodeAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = mealGroupCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
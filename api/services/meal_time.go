@@ -0,0 +1,189 @@
+// api/services/meal_time.go
+
+package services
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// 리뷰가 적은 부모는 전체 분포 쪽으로, 리뷰가 적은 음식은 부모 분포 쪽으로 이만큼의 리뷰 수로 당김
+	mealTimeParentPrior = 20.0
+	mealTimeFoodPrior   = 10.0
+)
+
+type MealTimeService interface {
+	RefreshSuitability(ctx context.Context) error
+}
+
+type mealTimeService struct {
+	mealTimeRepo repositories.MealTimeRepository
+	reviewRepo   repositories.ReviewRepository
+	foodRepo     repositories.FoodRepository
+}
+
+func NewMealTimeService(
+	mtr repositories.MealTimeRepository,
+	rr repositories.ReviewRepository,
+	fr repositories.FoodRepository,
+) MealTimeService {
+	return &mealTimeService{
+		mealTimeRepo: mtr,
+		reviewRepo:   rr,
+		foodRepo:     fr,
+	}
+}
+
+// 시간대별 리뷰 비율을 보정한 뒤 전체 비율로 나눈 배수를 저장. 1보다 크면 그 시간대에 많이 먹는 음식
+func (s *mealTimeService) RefreshSuitability(ctx context.Context) error {
+	now := refreshTime()
+
+	rows, err := s.reviewRepo.AggregateStandardMealTimes(ctx)
+	if err != nil {
+		return err
+	}
+
+	foodCounts := make(map[primitive.ObjectID]map[string]float64)
+	for _, row := range rows {
+		mealTime, ok := models.NormalizeMealTime(row.MealTime)
+		if !ok {
+			continue
+		}
+		fID, err := primitive.ObjectIDFromHex(row.FoodID)
+		if err != nil {
+			continue
+		}
+		if foodCounts[fID] == nil {
+			foodCounts[fID] = make(map[string]float64)
+		}
+		foodCounts[fID][mealTime] += float64(row.Count)
+	}
+	if len(foodCounts) == 0 {
+		return s.mealTimeRepo.DeleteComputedBefore(ctx, now)
+	}
+
+	foodIDs := make([]primitive.ObjectID, 0, len(foodCounts))
+	for id := range foodCounts {
+		foodIDs = append(foodIDs, id)
+	}
+	foods, err := s.foodRepo.FindStandardByIDs(ctx, foodIDs)
+	if err != nil {
+		return err
+	}
+
+	globalCounts := make(map[string]float64)
+	parentCounts := make(map[string]map[string]float64)
+	foodParents := make(map[primitive.ObjectID][]string, len(foods))
+	for _, food := range foods {
+		foodParents[food.ID] = food.Parents
+		for mealTime, count := range foodCounts[food.ID] {
+			globalCounts[mealTime] += count
+			for _, parent := range food.Parents {
+				if parentCounts[parent] == nil {
+					parentCounts[parent] = make(map[string]float64)
+				}
+				parentCounts[parent][mealTime] += count
+			}
+		}
+	}
+	globalShares := mealTimeShares(globalCounts, nil, 0)
+
+	results := make([]models.MealTimeSuitability, 0, len(foods)+len(parentCounts))
+	parentShares := make(map[string]map[string]float64, len(parentCounts))
+	for parent, counts := range parentCounts {
+		shares := mealTimeShares(counts, globalShares, mealTimeParentPrior)
+		parentShares[parent] = shares
+		results = append(results, models.MealTimeSuitability{
+			Scope:       models.MealTimeScopeParent,
+			Target:      parent,
+			Weights:     mealTimeWeights(shares, globalShares),
+			ReviewCount: int(sumCounts(counts)),
+			ComputedAt:  now,
+		})
+	}
+
+	for foodID, parents := range foodParents {
+		// 부모가 여럿이면 부모 분포의 평균을 기준으로 삼음
+		prior := globalShares
+		if len(parents) > 0 {
+			prior = make(map[string]float64, len(models.MealTimes))
+			for _, parent := range parents {
+				for mealTime, share := range parentShares[parent] {
+					prior[mealTime] += share / float64(len(parents))
+				}
+			}
+		}
+
+		counts := foodCounts[foodID]
+		results = append(results, models.MealTimeSuitability{
+			Scope:       models.MealTimeScopeFood,
+			Target:      foodID.Hex(),
+			Weights:     mealTimeWeights(mealTimeShares(counts, prior, mealTimeFoodPrior), globalShares),
+			ReviewCount: int(sumCounts(counts)),
+			ComputedAt:  now,
+		})
+	}
+
+	if err := s.mealTimeRepo.UpsertMany(ctx, results); err != nil {
+		return err
+	}
+	return s.mealTimeRepo.DeleteComputedBefore(ctx, now)
+}
+
+// 개수에 prior 분포를 priorWeight개만큼 더해 비율로 바꿈
+func mealTimeShares(counts map[string]float64, prior map[string]float64, priorWeight float64) map[string]float64 {
+	total := sumCounts(counts) + priorWeight
+	shares := make(map[string]float64, len(models.MealTimes))
+	if total == 0 {
+		return shares
+	}
+	for _, mealTime := range models.MealTimes {
+		shares[mealTime] = (counts[mealTime] + priorWeight*prior[mealTime]) / total
+	}
+	return shares
+}
+
+func mealTimeWeights(shares map[string]float64, globalShares map[string]float64) map[string]float64 {
+	weights := make(map[string]float64, len(models.MealTimes))
+	for _, mealTime := range models.MealTimes {
+		if globalShares[mealTime] > 0 {
+			weights[mealTime] = math.Round(shares[mealTime]/globalShares[mealTime]*1000) / 1000
+		}
+	}
+	return weights
+}
+
+func sumCounts(counts map[string]float64) float64 {
+	total := 0.0
+	for _, count := range counts {
+		total += count
+	}
+	return total
+}
+
+// 명시한 시간대가 있으면 그대로, 없으면 timezone(IANA, 기본 Asia/Seoul)의 현재 시각으로 정함
+func resolveMealTime(mealTime string, timezone string, now time.Time) (string, error) {
+	if mealTime != "" {
+		normalized, ok := models.NormalizeMealTime(mealTime)
+		if !ok {
+			return "", apperr.BadRequest("invalid meal time", nil)
+		}
+		return normalized, nil
+	}
+
+	if timezone == "" {
+		timezone = "Asia/Seoul"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", apperr.BadRequest("invalid timezone", err)
+	}
+	return models.MealTimeAt(now.In(loc)), nil
+}
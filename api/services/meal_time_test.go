@@ -0,0 +1,92 @@
+// api/services/meal_time_test.go
+
+package services
+
+import (
+	"math"
+	"testing"
+
+	"github.com/seojoonrp/bapddang-server/models"
+)
+
+func TestMealTimeShares(t *testing.T) {
+	uniform := map[string]float64{
+		models.MealTimeBreakfast: 0.25,
+		models.MealTimeLunch:     0.25,
+		models.MealTimeDinner:    0.25,
+		models.MealTimeLateNight: 0.25,
+	}
+
+	tests := []struct {
+		name        string
+		counts      map[string]float64
+		prior       map[string]float64
+		priorWeight float64
+		want        map[string]float64
+	}{
+		{
+			name:        "nothing to count",
+			counts:      nil,
+			prior:       uniform,
+			priorWeight: 0,
+			want:        map[string]float64{},
+		},
+		{
+			name:        "counts only",
+			counts:      map[string]float64{models.MealTimeBreakfast: 1, models.MealTimeLunch: 3},
+			prior:       uniform,
+			priorWeight: 0,
+			want: map[string]float64{
+				models.MealTimeBreakfast: 0.25,
+				models.MealTimeLunch:     0.75,
+				models.MealTimeDinner:    0,
+				models.MealTimeLateNight: 0,
+			},
+		},
+		{
+			name:        "prior only",
+			counts:      map[string]float64{},
+			prior:       uniform,
+			priorWeight: 4,
+			want:        uniform,
+		},
+		{
+			name:        "few reviews lean on the prior",
+			counts:      map[string]float64{models.MealTimeDinner: 6},
+			prior:       map[string]float64{models.MealTimeLunch: 0.5, models.MealTimeDinner: 0.5},
+			priorWeight: 4,
+			want: map[string]float64{
+				models.MealTimeBreakfast: 0,
+				models.MealTimeLunch:     0.2,
+				models.MealTimeDinner:    0.8,
+				models.MealTimeLateNight: 0,
+			},
+		},
+		{
+			name:        "many reviews outweigh the prior",
+			counts:      map[string]float64{models.MealTimeLateNight: 96},
+			prior:       uniform,
+			priorWeight: 4,
+			want: map[string]float64{
+				models.MealTimeBreakfast: 0.01,
+				models.MealTimeLunch:     0.01,
+				models.MealTimeDinner:    0.01,
+				models.MealTimeLateNight: 0.97,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mealTimeShares(tt.counts, tt.prior, tt.priorWeight)
+			if len(got) != len(tt.want) {
+				t.Fatalf("shares = %v, want %v", got, tt.want)
+			}
+			for mealTime, want := range tt.want {
+				if share, ok := got[mealTime]; !ok || math.Abs(share-want) > 1e-9 {
+					t.Errorf("share[%s] = %v, want %v", mealTime, share, want)
+				}
+			}
+		})
+	}
+}
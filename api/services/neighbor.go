@@ -0,0 +1,184 @@
+// api/services/neighbor.go
+
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// 평점이 이 이상인 리뷰만 선호로 봄
+	neighborMinRating = 4
+	// 우연을 걸러내기 위해 최소 이 수 이상의 사용자가 함께 선호한 음식만 이웃으로 사용
+	neighborMinCoUsers = 2
+	// 함께 선호한 사용자가 적을수록 유사도를 깎는 정도
+	neighborShrinkage = 5.0
+	// 음식마다 저장하는 이웃 수
+	neighborTopK = 20
+	// 한 사용자가 너무 많은 쌍을 만들지 않도록 사용자당 반영하는 음식 수
+	neighborMaxItemsPerUser = 300
+)
+
+type NeighborService interface {
+	RefreshNeighbors(ctx context.Context) error
+}
+
+type neighborService struct {
+	neighborRepo repositories.NeighborRepository
+	likeRepo     repositories.LikeRepository
+	reviewRepo   repositories.ReviewRepository
+}
+
+func NewNeighborService(
+	nr repositories.NeighborRepository,
+	lr repositories.LikeRepository,
+	rr repositories.ReviewRepository,
+) NeighborService {
+	return &neighborService{
+		neighborRepo: nr,
+		likeRepo:     lr,
+		reviewRepo:   rr,
+	}
+}
+
+func (s *neighborService) RefreshNeighbors(ctx context.Context) error {
+	now := refreshTime()
+
+	likes, err := s.likeRepo.FindAllUserFoodPairs(ctx)
+	if err != nil {
+		return err
+	}
+
+	ratings, err := s.reviewRepo.AggregateUserStandardRatings(ctx, neighborMinRating)
+	if err != nil {
+		return err
+	}
+
+	// 좋아요는 1, 리뷰는 평점 4면 0.5, 5면 1이며 둘 다 있으면 큰 쪽을 사용
+	preferences := make(map[primitive.ObjectID]map[primitive.ObjectID]float64)
+	prefer := func(userID, foodID primitive.ObjectID, weight float64) {
+		if preferences[userID] == nil {
+			preferences[userID] = make(map[primitive.ObjectID]float64)
+		}
+		if weight > preferences[userID][foodID] {
+			preferences[userID][foodID] = weight
+		}
+	}
+	for _, like := range likes {
+		prefer(like.UserID, like.FoodID, 1)
+	}
+	for _, rating := range ratings {
+		prefer(rating.UserID, rating.FoodID, float64(rating.Rating-3)/2)
+	}
+
+	if err := s.neighborRepo.UpsertMany(ctx, buildNeighbors(preferences, now)); err != nil {
+		return err
+	}
+	return s.neighborRepo.DeleteComputedBefore(ctx, now)
+}
+
+// 사용자별 선호 가중치로 음식 간 코사인 유사도를 구하고, 함께 선호한 사용자 수로 보정해 상위 neighborTopK개를 남김.
+// 전체 음식 쌍을 한꺼번에 들고 있지 않도록 음식별 선호 사용자 목록을 만든 뒤 음식 하나씩 이웃을 계산함
+func buildNeighbors(preferences map[primitive.ObjectID]map[primitive.ObjectID]float64, now time.Time) []models.FoodNeighbors {
+	type item struct {
+		foodID primitive.ObjectID
+		weight float64
+	}
+	type fan struct {
+		user   int
+		weight float64
+	}
+	type pairStat struct {
+		dot     float64
+		coUsers int
+	}
+
+	userItems := make([][]item, 0, len(preferences))
+	norms := make(map[primitive.ObjectID]float64)
+	fans := make(map[primitive.ObjectID][]fan)
+	for _, items := range preferences {
+		list := make([]item, 0, len(items))
+		for foodID, weight := range items {
+			list = append(list, item{foodID: foodID, weight: weight})
+		}
+		if len(list) > neighborMaxItemsPerUser {
+			sort.Slice(list, func(i, j int) bool {
+				return list[i].weight > list[j].weight
+			})
+			list = list[:neighborMaxItemsPerUser]
+		}
+
+		user := len(userItems)
+		userItems = append(userItems, list)
+		for _, it := range list {
+			norms[it.foodID] += it.weight * it.weight
+			fans[it.foodID] = append(fans[it.foodID], fan{user: user, weight: it.weight})
+		}
+	}
+
+	results := make([]models.FoodNeighbors, 0, len(fans))
+	stats := make(map[primitive.ObjectID]*pairStat)
+	for a, aFans := range fans {
+		if len(aFans) < neighborMinCoUsers {
+			continue
+		}
+
+		clear(stats)
+		for _, f := range aFans {
+			for _, b := range userItems[f.user] {
+				if b.foodID == a {
+					continue
+				}
+				stat := stats[b.foodID]
+				if stat == nil {
+					stat = &pairStat{}
+					stats[b.foodID] = stat
+				}
+				stat.dot += f.weight * b.weight
+				stat.coUsers++
+			}
+		}
+
+		var entries []models.NeighborEntry
+		for b, stat := range stats {
+			if stat.coUsers < neighborMinCoUsers {
+				continue
+			}
+			cosine := stat.dot / math.Sqrt(norms[a]*norms[b])
+			shrink := float64(stat.coUsers) / (float64(stat.coUsers) + neighborShrinkage)
+			entries = append(entries, models.NeighborEntry{
+				FoodID:     b,
+				Similarity: math.Round(cosine*shrink*1000) / 1000,
+				CoUsers:    stat.coUsers,
+			})
+		}
+		if len(entries) == 0 {
+			continue
+		}
+
+		sort.Slice(entries, func(i, j int) bool {
+			if entries[i].Similarity != entries[j].Similarity {
+				return entries[i].Similarity > entries[j].Similarity
+			}
+			return entries[i].CoUsers > entries[j].CoUsers
+		})
+		if len(entries) > neighborTopK {
+			entries = entries[:neighborTopK]
+		}
+
+		results = append(results, models.FoodNeighbors{
+			FoodID:     a,
+			Neighbors:  entries,
+			ComputedAt: now,
+		})
+	}
+
+	return results
+}
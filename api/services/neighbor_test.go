@@ -0,0 +1,129 @@
+// api/services/neighbor_test.go
+
+package services
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestBuildNeighbors(t *testing.T) {
+	a, b, c := testObjectID(1), testObjectID(2), testObjectID(3)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type prefs = map[primitive.ObjectID]float64
+	users := func(items ...prefs) map[primitive.ObjectID]map[primitive.ObjectID]float64 {
+		preferences := make(map[primitive.ObjectID]map[primitive.ObjectID]float64, len(items))
+		for i, p := range items {
+			preferences[testObjectID(byte(100+i))] = p
+		}
+		return preferences
+	}
+
+	// 사용자당 반영 한도를 넘는 선호 목록: x만 가중치가 낮아 잘려 나감
+	x := testObjectID(10)
+	crowded := prefs{x: 0.5}
+	for i := 0; i < neighborMaxItemsPerUser; i++ {
+		var id primitive.ObjectID
+		binary.BigEndian.PutUint32(id[:4], uint32(i+1))
+		crowded[id] = 1
+	}
+
+	tests := []struct {
+		name        string
+		preferences map[primitive.ObjectID]map[primitive.ObjectID]float64
+		want        map[primitive.ObjectID][]models.NeighborEntry
+	}{
+		{
+			name:        "no preferences",
+			preferences: nil,
+			want:        map[primitive.ObjectID][]models.NeighborEntry{},
+		},
+		{
+			name:        "identical taste is shrunk by co-user count",
+			preferences: users(prefs{a: 1, b: 1}, prefs{a: 1, b: 1}),
+			want: map[primitive.ObjectID][]models.NeighborEntry{
+				a: {{FoodID: b, Similarity: 0.286, CoUsers: 2}},
+				b: {{FoodID: a, Similarity: 0.286, CoUsers: 2}},
+			},
+		},
+		{
+			name:        "single co-user is dropped",
+			preferences: users(prefs{a: 1, b: 1}, prefs{a: 1}, prefs{b: 0.5}),
+			want:        map[primitive.ObjectID][]models.NeighborEntry{},
+		},
+		{
+			name:        "weights change cosine",
+			preferences: users(prefs{a: 1, b: 0.5}, prefs{a: 0.5, b: 1}),
+			want: map[primitive.ObjectID][]models.NeighborEntry{
+				a: {{FoodID: b, Similarity: 0.229, CoUsers: 2}},
+				b: {{FoodID: a, Similarity: 0.229, CoUsers: 2}},
+			},
+		},
+		{
+			name: "neighbours ordered by similarity",
+			preferences: users(
+				prefs{a: 1, b: 1, c: 1},
+				prefs{a: 1, b: 1, c: 0.5},
+				prefs{a: 1, b: 0.5},
+			),
+			want: map[primitive.ObjectID][]models.NeighborEntry{
+				a: {
+					{FoodID: b, Similarity: 0.361, CoUsers: 3},
+					{FoodID: c, Similarity: 0.221, CoUsers: 2},
+				},
+				b: {
+					{FoodID: a, Similarity: 0.361, CoUsers: 3},
+					{FoodID: c, Similarity: 0.256, CoUsers: 2},
+				},
+				c: {
+					{FoodID: b, Similarity: 0.256, CoUsers: 2},
+					{FoodID: a, Similarity: 0.221, CoUsers: 2},
+				},
+			},
+		},
+		{
+			name:        "items beyond the per-user cap are ignored",
+			preferences: users(crowded, prefs{x: 1, a: 1}),
+			want:        map[primitive.ObjectID][]models.NeighborEntry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[primitive.ObjectID][]models.NeighborEntry)
+			for _, n := range buildNeighbors(tt.preferences, now) {
+				if !n.ComputedAt.Equal(now) {
+					t.Errorf("food %s computedAt = %v, want %v", n.FoodID.Hex(), n.ComputedAt, now)
+				}
+				got[n.FoodID] = n.Neighbors
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("neighbors = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildNeighborsKeepsTopK(t *testing.T) {
+	base := testObjectID(1)
+	preferences := make(map[primitive.ObjectID]map[primitive.ObjectID]float64)
+	for u := 0; u < 2; u++ {
+		items := map[primitive.ObjectID]float64{base: 1}
+		for i := 0; i < neighborTopK+5; i++ {
+			items[testObjectID(byte(10+i))] = 1
+		}
+		preferences[testObjectID(byte(200+u))] = items
+	}
+
+	for _, n := range buildNeighbors(preferences, time.Now()) {
+		if len(n.Neighbors) != neighborTopK {
+			t.Errorf("food %s has %d neighbors, want %d", n.FoodID.Hex(), len(n.Neighbors), neighborTopK)
+		}
+	}
+}
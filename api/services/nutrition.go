@@ -0,0 +1,164 @@
+// api/services/nutrition.go
+
+package services
+
+import (
+	"context"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type NutritionService interface {
+	GetDailySummary(ctx context.Context, userID string, day int) (*models.DailyNutritionSummary, error)
+	GetWeeklySummary(ctx context.Context, userID string, week int) (*models.WeeklyNutritionSummary, error)
+}
+
+type nutritionService struct {
+	reviewRepo repositories.ReviewRepository
+	foodRepo   repositories.FoodRepository
+}
+
+func NewNutritionService(rr repositories.ReviewRepository, fr repositories.FoodRepository) NutritionService {
+	return &nutritionService{
+		reviewRepo: rr,
+		foodRepo:   fr,
+	}
+}
+
+func isValidNutrition(n *models.Nutrition) bool {
+	if n == nil {
+		return true
+	}
+	return n.Kcal >= 0 && n.Carbs >= 0 && n.Protein >= 0 && n.Fat >= 0 && n.Sodium >= 0
+}
+
+// 리뷰들에 포함된 표준 음식의 영양 정보를 한 번에 조회
+func (s *nutritionService) fetchNutritionMap(ctx context.Context, reviews []models.Review) (map[string]*models.Nutrition, error) {
+	foodIDs := make([]primitive.ObjectID, 0)
+	seen := make(map[primitive.ObjectID]bool)
+	for _, r := range reviews {
+		for _, f := range r.Foods {
+			if f.Type != models.FoodTypeStandard {
+				continue
+			}
+			fID, err := primitive.ObjectIDFromHex(f.FoodID)
+			if err != nil || seen[fID] {
+				continue
+			}
+			seen[fID] = true
+			foodIDs = append(foodIDs, fID)
+		}
+	}
+
+	foods, err := s.foodRepo.FindStandardByIDs(ctx, foodIDs)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch food details", err)
+	}
+
+	nutritionMap := make(map[string]*models.Nutrition, len(foods))
+	for _, food := range foods {
+		nutritionMap[food.ID.Hex()] = food.Nutrition
+	}
+
+	return nutritionMap, nil
+}
+
+func summarizeDay(day int, reviews []models.Review, nutritionMap map[string]*models.Nutrition) models.DailyNutritionSummary {
+	summary := models.DailyNutritionSummary{
+		Day:            day,
+		ByMealTime:     make(map[string]models.Nutrition),
+		UntrackedFoods: []string{},
+	}
+
+	for _, r := range reviews {
+		summary.MealCount++
+		for _, f := range r.Foods {
+			nutrition := nutritionMap[f.FoodID]
+			if f.Type != models.FoodTypeStandard || nutrition == nil {
+				summary.UntrackedFoods = append(summary.UntrackedFoods, f.FoodName)
+				continue
+			}
+
+			portion := f.EffectivePortion()
+			summary.Total = summary.Total.Add(*nutrition, portion)
+			summary.ByMealTime[r.MealTime] = summary.ByMealTime[r.MealTime].Add(*nutrition, portion)
+		}
+	}
+
+	return summary
+}
+
+func (s *nutritionService) GetDailySummary(ctx context.Context, userID string, day int) (*models.DailyNutritionSummary, error) {
+	if day <= 0 {
+		return nil, apperr.BadRequest("day must be a positive integer", nil)
+	}
+
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	reviews, err := s.reviewRepo.FindByUserIDAndDay(ctx, uID, day)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch reviews", err)
+	}
+
+	nutritionMap, err := s.fetchNutritionMap(ctx, reviews)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := summarizeDay(day, reviews, nutritionMap)
+	return &summary, nil
+}
+
+func (s *nutritionService) GetWeeklySummary(ctx context.Context, userID string, week int) (*models.WeeklyNutritionSummary, error) {
+	if week <= 0 {
+		return nil, apperr.BadRequest("week must be a positive integer", nil)
+	}
+
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	firstDay := (week-1)*7 + 1
+	reviews, err := s.reviewRepo.FindByUserIDAndDayRange(ctx, uID, firstDay, firstDay+6)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch reviews", err)
+	}
+
+	nutritionMap, err := s.fetchNutritionMap(ctx, reviews)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewsByDay := make(map[int][]models.Review)
+	for _, r := range reviews {
+		reviewsByDay[r.Day] = append(reviewsByDay[r.Day], r)
+	}
+
+	result := &models.WeeklyNutritionSummary{
+		Week: week,
+		Days: []models.DailyNutritionSummary{},
+	}
+
+	for day := firstDay; day < firstDay+7; day++ {
+		dayReviews, ok := reviewsByDay[day]
+		if !ok {
+			continue
+		}
+		summary := summarizeDay(day, dayReviews, nutritionMap)
+		result.Days = append(result.Days, summary)
+		result.Total = result.Total.Add(summary.Total, 1)
+	}
+
+	if len(result.Days) > 0 {
+		result.DailyAverage = result.Total.Scale(1 / float64(len(result.Days)))
+	}
+
+	return result, nil
+}
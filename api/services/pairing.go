@@ -0,0 +1,302 @@
+// api/services/pairing.go
+
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// 우연을 걸러내기 위해 최소 이 횟수 이상 함께 기록된 조합만 사용
+	pairingMinCount = 2
+	// 음식마다 저장하는 짝 음식 수
+	pairingMaxPairs = 20
+	// 작업마다 읽는 리뷰가 끝없이 늘지 않도록 이 기간 안의 리뷰로만 계산
+	pairingWindow = 180 * 24 * time.Hour
+)
+
+type PairingService interface {
+	RefreshPairings(ctx context.Context) error
+	GetPairings(ctx context.Context, userID string, foodID string, count int, locale string) ([]models.PairedFood, error)
+	SuggestForComposer(ctx context.Context, userID string, foodIDs []string, count int, locale string) ([]models.PairedFood, error)
+}
+
+type pairingService struct {
+	pairingRepo repositories.PairingRepository
+	reviewRepo  repositories.ReviewRepository
+	foodRepo    repositories.FoodRepository
+	likeRepo    repositories.LikeRepository
+	userRepo    repositories.UserRepository
+}
+
+func NewPairingService(
+	pr repositories.PairingRepository,
+	rr repositories.ReviewRepository,
+	fr repositories.FoodRepository,
+	lr repositories.LikeRepository,
+	ur repositories.UserRepository,
+) PairingService {
+	return &pairingService{
+		pairingRepo: pr,
+		reviewRepo:  rr,
+		foodRepo:    fr,
+		likeRepo:    lr,
+		userRepo:    ur,
+	}
+}
+
+// 리뷰 하나를 장바구니로 보고 음식 쌍의 confidence와 lift를 계산
+func buildPairings(baskets [][]string, now time.Time) []models.FoodPairing {
+	foodCounts := make(map[primitive.ObjectID]int)
+	pairCounts := make(map[primitive.ObjectID]map[primitive.ObjectID]int)
+
+	for _, basket := range baskets {
+		seen := make(map[primitive.ObjectID]bool, len(basket))
+		ids := make([]primitive.ObjectID, 0, len(basket))
+		for _, hex := range basket {
+			id, err := primitive.ObjectIDFromHex(hex)
+			if err != nil || seen[id] {
+				continue
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+
+		for _, a := range ids {
+			foodCounts[a]++
+			for _, b := range ids {
+				if a == b {
+					continue
+				}
+				if pairCounts[a] == nil {
+					pairCounts[a] = make(map[primitive.ObjectID]int)
+				}
+				pairCounts[a][b]++
+			}
+		}
+	}
+
+	total := float64(len(baskets))
+	pairings := make([]models.FoodPairing, 0, len(pairCounts))
+	for a, partners := range pairCounts {
+		var entries []models.PairingEntry
+		for b, count := range partners {
+			if count < pairingMinCount {
+				continue
+			}
+			confidence := float64(count) / float64(foodCounts[a])
+			lift := confidence * total / float64(foodCounts[b])
+			// 따로 먹을 때보다 함께 먹는 경향이 있는 조합만 남김
+			if lift <= 1 {
+				continue
+			}
+			entries = append(entries, models.PairingEntry{
+				FoodID:     b,
+				PairCount:  count,
+				Confidence: math.Round(confidence*1000) / 1000,
+				Lift:       math.Round(lift*100) / 100,
+			})
+		}
+		if len(entries) == 0 {
+			continue
+		}
+
+		sort.Slice(entries, func(i, j int) bool {
+			if entries[i].Confidence != entries[j].Confidence {
+				return entries[i].Confidence > entries[j].Confidence
+			}
+			return entries[i].Lift > entries[j].Lift
+		})
+		if len(entries) > pairingMaxPairs {
+			entries = entries[:pairingMaxPairs]
+		}
+
+		pairings = append(pairings, models.FoodPairing{
+			FoodID:     a,
+			Pairs:      entries,
+			ComputedAt: now,
+		})
+	}
+
+	return pairings
+}
+
+// 주기 작업이 결과에 기록하고 이전 결과를 정리하는 기준 시각.
+// DB에는 밀리초 단위로 저장되므로, 방금 쓴 문서가 정리 대상이 되지 않도록 맞춤
+func refreshTime() time.Time {
+	return time.Now().Truncate(time.Millisecond)
+}
+
+func (s *pairingService) RefreshPairings(ctx context.Context) error {
+	now := refreshTime()
+
+	baskets, err := s.reviewRepo.FindStandardBasketsSince(ctx, now.Add(-pairingWindow))
+	if err != nil {
+		return err
+	}
+
+	if err := s.pairingRepo.UpsertMany(ctx, buildPairings(baskets, now)); err != nil {
+		return err
+	}
+
+	return s.pairingRepo.DeleteComputedBefore(ctx, now)
+}
+
+// entries 순서대로 음식을 불러와 식단 프로필로 거른 뒤 좋아요 여부를 붙임
+func (s *pairingService) toPairedFoods(ctx context.Context, uID primitive.ObjectID, user *models.User, entries []models.PairingEntry, count int, locale string) ([]models.PairedFood, error) {
+	foodIDs := make([]primitive.ObjectID, 0, len(entries))
+	for _, entry := range entries {
+		foodIDs = append(foodIDs, entry.FoodID)
+	}
+	foods, err := s.foodRepo.FindStandardByIDs(ctx, foodIDs)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch paired foods", err)
+	}
+	foodByID := make(map[primitive.ObjectID]models.StandardFood, len(foods))
+	for _, f := range foods {
+		foodByID[f.ID] = *f
+	}
+
+	var kept []models.PairingEntry
+	var keptFoods []models.StandardFood
+	for _, entry := range entries {
+		if len(kept) >= count {
+			break
+		}
+		food, ok := foodByID[entry.FoodID]
+		if !ok || !user.DietaryProfile.Allows(food) {
+			continue
+		}
+		kept = append(kept, entry)
+		keptFoods = append(keptFoods, food)
+	}
+
+	responses, err := wrapFoodsWithLikeStatus(ctx, s.likeRepo, uID, keptFoods, preferredLocale(user, locale))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.PairedFood, 0, len(responses))
+	for i, response := range responses {
+		result = append(result, models.PairedFood{
+			FoodLikeResponse: response,
+			PairCount:        kept[i].PairCount,
+			Confidence:       kept[i].Confidence,
+			Lift:             kept[i].Lift,
+		})
+	}
+	return result, nil
+}
+
+func (s *pairingService) GetPairings(ctx context.Context, userID string, foodID string, count int, locale string) ([]models.PairedFood, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	fID, err := primitive.ObjectIDFromHex(foodID)
+	if err != nil {
+		return nil, apperr.BadRequest("invalid food ID format", err)
+	}
+
+	if count <= 0 || count > pairingMaxPairs {
+		return nil, apperr.BadRequest("invalid food count", nil)
+	}
+
+	user, err := fetchUser(ctx, s.userRepo, uID)
+	if err != nil {
+		return nil, err
+	}
+
+	food, err := findStandardFollowingRedirect(ctx, s.foodRepo, fID)
+	if err != nil {
+		return nil, err
+	}
+
+	pairings, err := s.pairingRepo.FindByFoodIDs(ctx, []primitive.ObjectID{food.ID})
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch food pairings", err)
+	}
+	if len(pairings) == 0 {
+		return []models.PairedFood{}, nil
+	}
+
+	return s.toPairedFoods(ctx, uID, user, pairings[0].Pairs, count, locale)
+}
+
+// 리뷰 작성 중 이미 고른 음식들과 자주 함께 먹는 음식을 confidence 합으로 추천
+func (s *pairingService) SuggestForComposer(ctx context.Context, userID string, foodIDs []string, count int, locale string) ([]models.PairedFood, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	if len(foodIDs) == 0 {
+		return nil, apperr.BadRequest("at least one food ID is required", nil)
+	}
+	if count <= 0 || count > pairingMaxPairs {
+		return nil, apperr.BadRequest("invalid food count", nil)
+	}
+
+	selected := make(map[primitive.ObjectID]bool, len(foodIDs))
+	fIDs := make([]primitive.ObjectID, 0, len(foodIDs))
+	for _, id := range foodIDs {
+		fID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, apperr.BadRequest("invalid food ID format", err)
+		}
+		if !selected[fID] {
+			selected[fID] = true
+			fIDs = append(fIDs, fID)
+		}
+	}
+
+	user, err := fetchUser(ctx, s.userRepo, uID)
+	if err != nil {
+		return nil, err
+	}
+
+	pairings, err := s.pairingRepo.FindByFoodIDs(ctx, fIDs)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch food pairings", err)
+	}
+
+	merged := make(map[primitive.ObjectID]*models.PairingEntry)
+	for _, pairing := range pairings {
+		for _, entry := range pairing.Pairs {
+			if selected[entry.FoodID] {
+				continue
+			}
+			m, ok := merged[entry.FoodID]
+			if !ok {
+				m = &models.PairingEntry{FoodID: entry.FoodID}
+				merged[entry.FoodID] = m
+			}
+			m.PairCount += entry.PairCount
+			m.Confidence += entry.Confidence
+			m.Lift = math.Max(m.Lift, entry.Lift)
+		}
+	}
+
+	entries := make([]models.PairingEntry, 0, len(merged))
+	for _, m := range merged {
+		m.Confidence = math.Round(m.Confidence*1000) / 1000
+		entries = append(entries, *m)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Confidence != entries[j].Confidence {
+			return entries[i].Confidence > entries[j].Confidence
+		}
+		return entries[i].PairCount > entries[j].PairCount
+	})
+
+	return s.toPairedFoods(ctx, uID, user, entries, count, locale)
+}
@@ -0,0 +1,124 @@
+// api/services/pairing_test.go
+
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestBuildPairings(t *testing.T) {
+	a, b, c, d := testObjectID(1), testObjectID(2), testObjectID(3), testObjectID(4)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	repeat := func(basket []string, n int) [][]string {
+		baskets := make([][]string, 0, n)
+		for i := 0; i < n; i++ {
+			baskets = append(baskets, basket)
+		}
+		return baskets
+	}
+	concat := func(groups ...[][]string) [][]string {
+		var baskets [][]string
+		for _, g := range groups {
+			baskets = append(baskets, g...)
+		}
+		return baskets
+	}
+
+	tests := []struct {
+		name    string
+		baskets [][]string
+		want    map[primitive.ObjectID][]models.PairingEntry
+	}{
+		{
+			name:    "no baskets",
+			baskets: nil,
+			want:    map[primitive.ObjectID][]models.PairingEntry{},
+		},
+		{
+			name: "pairs eaten together more than chance",
+			baskets: concat(
+				repeat([]string{a.Hex(), b.Hex()}, 3),
+				repeat([]string{c.Hex(), d.Hex()}, 2),
+				[][]string{{c.Hex()}, {d.Hex()}},
+			),
+			want: map[primitive.ObjectID][]models.PairingEntry{
+				a: {{FoodID: b, PairCount: 3, Confidence: 1, Lift: 2.33}},
+				b: {{FoodID: a, PairCount: 3, Confidence: 1, Lift: 2.33}},
+				c: {{FoodID: d, PairCount: 2, Confidence: 0.667, Lift: 1.56}},
+				d: {{FoodID: c, PairCount: 2, Confidence: 0.667, Lift: 1.56}},
+			},
+		},
+		{
+			name: "pairs below the minimum count are dropped",
+			baskets: [][]string{
+				{a.Hex(), b.Hex()},
+				{a.Hex(), c.Hex()},
+				{a.Hex(), c.Hex()},
+				{b.Hex()},
+			},
+			want: map[primitive.ObjectID][]models.PairingEntry{
+				a: {{FoodID: c, PairCount: 2, Confidence: 0.667, Lift: 1.33}},
+				c: {{FoodID: a, PairCount: 2, Confidence: 1, Lift: 1.33}},
+			},
+		},
+		{
+			name: "partner in every basket has no lift",
+			baskets: [][]string{
+				{a.Hex(), b.Hex()},
+				{a.Hex(), b.Hex()},
+				{a.Hex()},
+			},
+			want: map[primitive.ObjectID][]models.PairingEntry{},
+		},
+		{
+			name: "duplicate and malformed IDs are ignored",
+			baskets: [][]string{
+				{a.Hex(), a.Hex(), b.Hex(), "not-an-id"},
+				{a.Hex(), b.Hex()},
+				{c.Hex()},
+			},
+			want: map[primitive.ObjectID][]models.PairingEntry{
+				a: {{FoodID: b, PairCount: 2, Confidence: 1, Lift: 1.5}},
+				b: {{FoodID: a, PairCount: 2, Confidence: 1, Lift: 1.5}},
+			},
+		},
+		{
+			name: "partners ordered by confidence",
+			baskets: concat(
+				repeat([]string{a.Hex(), b.Hex()}, 3),
+				repeat([]string{a.Hex(), c.Hex()}, 2),
+				[][]string{{b.Hex()}, {c.Hex()}},
+				repeat([]string{d.Hex()}, 3),
+			),
+			want: map[primitive.ObjectID][]models.PairingEntry{
+				a: {
+					{FoodID: b, PairCount: 3, Confidence: 0.6, Lift: 1.5},
+					{FoodID: c, PairCount: 2, Confidence: 0.4, Lift: 1.33},
+				},
+				b: {{FoodID: a, PairCount: 3, Confidence: 0.75, Lift: 1.5}},
+				c: {{FoodID: a, PairCount: 2, Confidence: 0.667, Lift: 1.33}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[primitive.ObjectID][]models.PairingEntry)
+			for _, p := range buildPairings(tt.baskets, now) {
+				if !p.ComputedAt.Equal(now) {
+					t.Errorf("food %s computedAt = %v, want %v", p.FoodID.Hex(), p.ComputedAt, now)
+				}
+				got[p.FoodID] = p.Pairs
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pairings = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
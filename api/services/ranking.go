@@ -0,0 +1,368 @@
+// api/services/ranking.go
+
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// 스냅샷에 저장하는 음식 수 (식단 프로필로 걸러질 것을 감안해 넉넉히)
+	rankingSnapshotSize = 100
+	// 리뷰가 이 개수만큼 전체 평균 평점으로 채워져 있다고 보고 평균을 보정
+	bayesianPriorWeight = 5.0
+
+	// 급상승 순위는 최근 3일 활동을 12시간 반감기로 감쇠해 합산하며, 리뷰를 좋아요보다 무겁게 반영
+	trendingWindow       = 72 * time.Hour
+	trendingHalfLife     = 12 * time.Hour
+	trendingReviewWeight = 3.0
+	trendingLikeWeight   = 1.0
+)
+
+type RankingService interface {
+	RefreshSnapshots(ctx context.Context) error
+	GetRankings(ctx context.Context, userID string, kind string, period string, count int, locale string) (*models.RankingResponse, error)
+
+	RefreshTrending(ctx context.Context) error
+	GetTrending(ctx context.Context, userID string, count int, locale string) (*models.RankingResponse, error)
+}
+
+type rankingService struct {
+	rankingRepo repositories.RankingRepository
+	foodRepo    repositories.FoodRepository
+	reviewRepo  repositories.ReviewRepository
+	likeRepo    repositories.LikeRepository
+	userRepo    repositories.UserRepository
+}
+
+func NewRankingService(
+	rr repositories.RankingRepository,
+	fr repositories.FoodRepository,
+	rvr repositories.ReviewRepository,
+	lr repositories.LikeRepository,
+	ur repositories.UserRepository,
+) RankingService {
+	return &rankingService{
+		rankingRepo: rr,
+		foodRepo:    fr,
+		reviewRepo:  rvr,
+		likeRepo:    lr,
+		userRepo:    ur,
+	}
+}
+
+// 이번 주는 월요일 0시, 이번 달은 1일 0시(KST)부터이며 전체 기간은 zero time
+func rankingPeriodStart(period string, now time.Time) time.Time {
+	seoulLoc, _ := time.LoadLocation("Asia/Seoul")
+	nowKST := now.In(seoulLoc)
+	todayZero := time.Date(nowKST.Year(), nowKST.Month(), nowKST.Day(), 0, 0, 0, 0, seoulLoc)
+
+	switch period {
+	case models.RankingPeriodWeek:
+		daysSinceMonday := (int(todayZero.Weekday()) + 6) % 7
+		return todayZero.AddDate(0, 0, -daysSinceMonday)
+	case models.RankingPeriodMonth:
+		return time.Date(nowKST.Year(), nowKST.Month(), 1, 0, 0, 0, 0, seoulLoc)
+	default:
+		return time.Time{}
+	}
+}
+
+func (s *rankingService) fetchPeriodStats(ctx context.Context, period string, since time.Time) ([]models.FoodRatingStat, map[primitive.ObjectID]int, error) {
+	// 전체 기간은 음식 문서에 누적된 값을 그대로 사용
+	if period == models.RankingPeriodAll {
+		ratings, err := s.foodRepo.FindStandardReviewStats(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		likes, err := s.foodRepo.FindStandardLikeCounts(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ratings, likes, nil
+	}
+
+	ratings, err := s.reviewRepo.AggregateStandardRatingsSince(ctx, since)
+	if err != nil {
+		return nil, nil, err
+	}
+	likes, err := s.likeRepo.CountByFoodSince(ctx, since)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ratings, likes, nil
+}
+
+func averageRating(totalRating, reviewCount int) float64 {
+	if reviewCount == 0 {
+		return 0
+	}
+	return math.Round(float64(totalRating)/float64(reviewCount)*100) / 100
+}
+
+func buildRatingEntries(ratings []models.FoodRatingStat, likes map[primitive.ObjectID]int) []models.RankingEntry {
+	totalReviews, totalRating := 0, 0
+	for _, r := range ratings {
+		totalReviews += r.ReviewCount
+		totalRating += r.TotalRating
+	}
+	if totalReviews == 0 {
+		return []models.RankingEntry{}
+	}
+	globalMean := float64(totalRating) / float64(totalReviews)
+
+	entries := make([]models.RankingEntry, 0, len(ratings))
+	for _, r := range ratings {
+		score := (bayesianPriorWeight*globalMean + float64(r.TotalRating)) / (bayesianPriorWeight + float64(r.ReviewCount))
+		entries = append(entries, models.RankingEntry{
+			FoodID:        r.FoodID,
+			Score:         math.Round(score*1000) / 1000,
+			ReviewCount:   r.ReviewCount,
+			AverageRating: averageRating(r.TotalRating, r.ReviewCount),
+			LikeCount:     likes[r.FoodID],
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].ReviewCount > entries[j].ReviewCount
+	})
+	if len(entries) > rankingSnapshotSize {
+		entries = entries[:rankingSnapshotSize]
+	}
+	return entries
+}
+
+func buildLikeEntries(ratings []models.FoodRatingStat, likes map[primitive.ObjectID]int) []models.RankingEntry {
+	ratingByFood := make(map[primitive.ObjectID]models.FoodRatingStat, len(ratings))
+	for _, r := range ratings {
+		ratingByFood[r.FoodID] = r
+	}
+
+	entries := make([]models.RankingEntry, 0, len(likes))
+	for foodID, likeCount := range likes {
+		r := ratingByFood[foodID]
+		entries = append(entries, models.RankingEntry{
+			FoodID:        foodID,
+			Score:         float64(likeCount),
+			ReviewCount:   r.ReviewCount,
+			AverageRating: averageRating(r.TotalRating, r.ReviewCount),
+			LikeCount:     likeCount,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].LikeCount != entries[j].LikeCount {
+			return entries[i].LikeCount > entries[j].LikeCount
+		}
+		return entries[i].FoodID.Hex() < entries[j].FoodID.Hex()
+	})
+	if len(entries) > rankingSnapshotSize {
+		entries = entries[:rankingSnapshotSize]
+	}
+	return entries
+}
+
+func (s *rankingService) RefreshSnapshots(ctx context.Context) error {
+	now := time.Now()
+
+	for _, period := range models.RankingPeriods {
+		since := rankingPeriodStart(period, now)
+
+		ratings, likes, err := s.fetchPeriodStats(ctx, period, since)
+		if err != nil {
+			return err
+		}
+
+		snapshots := []*models.RankingSnapshot{
+			{Kind: models.RankingKindRating, Entries: buildRatingEntries(ratings, likes)},
+			{Kind: models.RankingKindLikes, Entries: buildLikeEntries(ratings, likes)},
+		}
+		for _, snapshot := range snapshots {
+			snapshot.Period = period
+			snapshot.PeriodStart = since
+			snapshot.ComputedAt = now
+			if err := s.rankingRepo.UpsertSnapshot(ctx, snapshot); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *rankingService) GetRankings(ctx context.Context, userID string, kind string, period string, count int, locale string) (*models.RankingResponse, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	if kind != models.RankingKindRating && kind != models.RankingKindLikes {
+		return nil, apperr.BadRequest("invalid ranking type", nil)
+	}
+	if !models.IsValidRankingPeriod(period) {
+		return nil, apperr.BadRequest("invalid ranking period", nil)
+	}
+	if count <= 0 || count > 50 {
+		return nil, apperr.BadRequest("invalid food count", nil)
+	}
+
+	snapshot, err := s.rankingRepo.FindSnapshot(ctx, kind, period)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch ranking snapshot", err)
+	}
+
+	return s.buildRankingResponse(ctx, uID, kind, period, snapshot, count, locale)
+}
+
+func (s *rankingService) buildRankingResponse(ctx context.Context, uID primitive.ObjectID, kind string, period string, snapshot *models.RankingSnapshot, count int, locale string) (*models.RankingResponse, error) {
+	user, err := fetchUser(ctx, s.userRepo, uID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.RankingResponse{
+		Kind:   kind,
+		Period: period,
+		Foods:  []models.RankedFood{},
+	}
+
+	// 아직 첫 집계 전이면 빈 목록
+	if snapshot == nil {
+		return result, nil
+	}
+	result.ComputedAt = snapshot.ComputedAt
+
+	foodIDs := make([]primitive.ObjectID, 0, len(snapshot.Entries))
+	for _, entry := range snapshot.Entries {
+		foodIDs = append(foodIDs, entry.FoodID)
+	}
+	foods, err := s.foodRepo.FindStandardByIDs(ctx, foodIDs)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch ranked foods", err)
+	}
+	foodByID := make(map[primitive.ObjectID]models.StandardFood, len(foods))
+	for _, f := range foods {
+		foodByID[f.ID] = *f
+	}
+
+	// 순위는 전체 기준으로 유지하고, 식단 프로필에 맞지 않거나 삭제된 음식만 건너뜀
+	var ranked []models.RankingEntry
+	var rankedFoods []models.StandardFood
+	var ranks []int
+	for i, entry := range snapshot.Entries {
+		if len(ranked) >= count {
+			break
+		}
+		food, ok := foodByID[entry.FoodID]
+		if !ok || !user.DietaryProfile.Allows(food) {
+			continue
+		}
+		ranked = append(ranked, entry)
+		rankedFoods = append(rankedFoods, food)
+		ranks = append(ranks, i+1)
+	}
+
+	responses, err := wrapFoodsWithLikeStatus(ctx, s.likeRepo, uID, rankedFoods, preferredLocale(user, locale))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, response := range responses {
+		result.Foods = append(result.Foods, models.RankedFood{
+			FoodLikeResponse: response,
+			Rank:             ranks[i],
+			Score:            ranked[i].Score,
+			ReviewCount:      ranked[i].ReviewCount,
+			AverageRating:    ranked[i].AverageRating,
+			LikeCount:        ranked[i].LikeCount,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *rankingService) RefreshTrending(ctx context.Context) error {
+	now := time.Now()
+	since := now.Add(-trendingWindow)
+
+	reviewStats, err := s.reviewRepo.AggregateStandardActivitySince(ctx, since, now, trendingHalfLife)
+	if err != nil {
+		return err
+	}
+	likeStats, err := s.likeRepo.AggregateActivitySince(ctx, since, now, trendingHalfLife)
+	if err != nil {
+		return err
+	}
+
+	entryByFood := make(map[primitive.ObjectID]*models.RankingEntry)
+	getEntry := func(foodID primitive.ObjectID) *models.RankingEntry {
+		entry, ok := entryByFood[foodID]
+		if !ok {
+			entry = &models.RankingEntry{FoodID: foodID}
+			entryByFood[foodID] = entry
+		}
+		return entry
+	}
+	for _, stat := range reviewStats {
+		entry := getEntry(stat.FoodID)
+		entry.ReviewCount = stat.Count
+		entry.Score += trendingReviewWeight * stat.DecayedScore
+	}
+	for _, stat := range likeStats {
+		entry := getEntry(stat.FoodID)
+		entry.LikeCount = stat.Count
+		entry.Score += trendingLikeWeight * stat.DecayedScore
+	}
+
+	entries := make([]models.RankingEntry, 0, len(entryByFood))
+	for _, entry := range entryByFood {
+		entry.Score = math.Round(entry.Score*1000) / 1000
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		return entries[i].FoodID.Hex() < entries[j].FoodID.Hex()
+	})
+	if len(entries) > rankingSnapshotSize {
+		entries = entries[:rankingSnapshotSize]
+	}
+
+	return s.rankingRepo.UpsertSnapshot(ctx, &models.RankingSnapshot{
+		Kind:        models.RankingKindTrending,
+		Period:      models.RankingPeriodRecent,
+		Entries:     entries,
+		PeriodStart: since,
+		ComputedAt:  now,
+	})
+}
+
+func (s *rankingService) GetTrending(ctx context.Context, userID string, count int, locale string) (*models.RankingResponse, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	if count <= 0 || count > 50 {
+		return nil, apperr.BadRequest("invalid food count", nil)
+	}
+
+	snapshot, err := s.rankingRepo.FindSnapshot(ctx, models.RankingKindTrending, models.RankingPeriodRecent)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to fetch trending snapshot", err)
+	}
+
+	return s.buildRankingResponse(ctx, uID, models.RankingKindTrending, models.RankingPeriodRecent, snapshot, count, locale)
+}
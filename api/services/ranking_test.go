@@ -0,0 +1,100 @@
+// api/services/ranking_test.go
+
+package services
+
+import (
+	"testing"
+
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 테스트용으로 마지막 바이트만 다른 고정 ObjectID
+func testObjectID(n byte) primitive.ObjectID {
+	var id primitive.ObjectID
+	id[len(id)-1] = n
+	return id
+}
+
+func TestBuildRatingEntries(t *testing.T) {
+	a, b, c, d, e := testObjectID(1), testObjectID(2), testObjectID(3), testObjectID(4), testObjectID(5)
+
+	manyFoods := make([]models.FoodRatingStat, 0, rankingSnapshotSize+50)
+	for i := 0; i < rankingSnapshotSize+50; i++ {
+		manyFoods = append(manyFoods, models.FoodRatingStat{FoodID: primitive.NewObjectID(), ReviewCount: 1, TotalRating: 3})
+	}
+
+	tests := []struct {
+		name       string
+		ratings    []models.FoodRatingStat
+		likes      map[primitive.ObjectID]int
+		wantIDs    []primitive.ObjectID
+		wantScores []float64
+		wantLen    int
+	}{
+		{
+			name:    "no reviews",
+			ratings: nil,
+			wantLen: 0,
+		},
+		{
+			// 전체 평균은 155/51. 리뷰 1개짜리 5점은 평균 쪽으로 끌려 내려가 리뷰가 많은 4.5점 아래로 감
+			name: "prior pulls sparse ratings toward the global mean",
+			ratings: []models.FoodRatingStat{
+				{FoodID: a, ReviewCount: 1, TotalRating: 5},
+				{FoodID: b, ReviewCount: 20, TotalRating: 90},
+				{FoodID: c, ReviewCount: 30, TotalRating: 60},
+			},
+			wantIDs:    []primitive.ObjectID{b, a, c},
+			wantScores: []float64{4.208, 3.366, 2.148},
+			wantLen:    3,
+		},
+		{
+			name: "equal scores are ordered by review count",
+			ratings: []models.FoodRatingStat{
+				{FoodID: d, ReviewCount: 2, TotalRating: 8},
+				{FoodID: e, ReviewCount: 4, TotalRating: 16},
+			},
+			wantIDs:    []primitive.ObjectID{e, d},
+			wantScores: []float64{4, 4},
+			wantLen:    2,
+		},
+		{
+			name:    "truncated to snapshot size",
+			ratings: manyFoods,
+			wantLen: rankingSnapshotSize,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildRatingEntries(tt.ratings, tt.likes)
+			if len(got) != tt.wantLen {
+				t.Fatalf("len = %d, want %d", len(got), tt.wantLen)
+			}
+			for i, id := range tt.wantIDs {
+				if got[i].FoodID != id {
+					t.Errorf("entry %d food = %s, want %s", i, got[i].FoodID.Hex(), id.Hex())
+				}
+				if got[i].Score != tt.wantScores[i] {
+					t.Errorf("entry %d score = %v, want %v", i, got[i].Score, tt.wantScores[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBuildRatingEntriesCarriesStats(t *testing.T) {
+	a := testObjectID(1)
+	got := buildRatingEntries(
+		[]models.FoodRatingStat{{FoodID: a, ReviewCount: 3, TotalRating: 13}},
+		map[primitive.ObjectID]int{a: 7},
+	)
+
+	if len(got) != 1 {
+		t.Fatalf("len = %d, want 1", len(got))
+	}
+	if got[0].ReviewCount != 3 || got[0].AverageRating != 4.33 || got[0].LikeCount != 7 {
+		t.Errorf("entry = %+v, want reviewCount 3, averageRating 4.33, likeCount 7", got[0])
+	}
+}
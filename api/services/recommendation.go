@@ -0,0 +1,169 @@
+// api/services/recommendation.go
+
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type RecommendationService interface {
+	RecordEvent(ctx context.Context, userID string, req models.CreateRecEventRequest) error
+	GetCTR(ctx context.Context, scope string, days int, count int) ([]models.CTRStat, error)
+}
+
+type recommendationService struct {
+	recEventRepo   repositories.RecEventRepository
+	recHistoryRepo repositories.RecHistoryRepository
+	likeRepo       repositories.LikeRepository
+	reviewRepo     repositories.ReviewRepository
+	reviewWindow   time.Duration
+}
+
+func NewRecommendationService(
+	rer repositories.RecEventRepository,
+	rhr repositories.RecHistoryRepository,
+	lr repositories.LikeRepository,
+	rr repositories.ReviewRepository,
+	reviewWindow time.Duration,
+) RecommendationService {
+	// 추천 기록이 TTL로 지워진 뒤에는 리뷰를 추천과 연결할 수 없음
+	if reviewWindow <= 0 || reviewWindow > models.RecHistoryRetention {
+		log.Printf("[WARNING] recommendation review window %s is out of range, using %s", reviewWindow, models.RecHistoryRetention)
+		reviewWindow = models.RecHistoryRetention
+	}
+
+	return &recommendationService{
+		recEventRepo:   rer,
+		recHistoryRepo: rhr,
+		likeRepo:       lr,
+		reviewRepo:     rr,
+		reviewWindow:   reviewWindow,
+	}
+}
+
+// 같은 추천의 같은 음식에 같은 반응은 한 번만 집계됨
+func (s *recommendationService) RecordEvent(ctx context.Context, userID string, req models.CreateRecEventRequest) error {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	recID, err := primitive.ObjectIDFromHex(req.RecommendationID)
+	if err != nil {
+		return apperr.BadRequest("invalid recommendation ID format", err)
+	}
+
+	fID, err := primitive.ObjectIDFromHex(req.FoodID)
+	if err != nil {
+		return apperr.BadRequest("invalid food ID format", err)
+	}
+
+	if req.Type != models.RecEventClick && req.Type != models.RecEventLike && req.Type != models.RecEventReview {
+		return apperr.BadRequest("invalid event type", nil)
+	}
+
+	history, err := s.recHistoryRepo.FindByID(ctx, recID)
+	if err != nil {
+		return apperr.InternalServerError("failed to fetch recommendation", err)
+	}
+	if history == nil {
+		return apperr.NotFound("recommendation not found", nil)
+	}
+	if history.UserID != uID {
+		return apperr.Unauthorized("you are not the owner of this recommendation", nil)
+	}
+
+	recommended := false
+	for _, id := range history.FoodIDs {
+		if id == fID {
+			recommended = true
+			break
+		}
+	}
+	if !recommended {
+		return apperr.BadRequest("food was not in this recommendation", nil)
+	}
+
+	now := time.Now()
+	switch req.Type {
+	case models.RecEventLike:
+		likedMap, err := s.likeRepo.CheckLikedStatus(ctx, uID, []primitive.ObjectID{fID})
+		if err != nil {
+			return apperr.InternalServerError("failed to check liked status", err)
+		}
+		if !likedMap[fID] {
+			return apperr.BadRequest("food is not liked", nil)
+		}
+	case models.RecEventReview:
+		windowEnd := history.CreatedAt.Add(s.reviewWindow)
+		if now.After(windowEnd) {
+			return apperr.BadRequest("review was logged too long after the recommendation", nil)
+		}
+		reviewed, err := s.reviewRepo.ExistsStandardByUserBetween(ctx, uID, fID.Hex(), history.CreatedAt, windowEnd)
+		if err != nil {
+			return apperr.InternalServerError("failed to check reviews", err)
+		}
+		if !reviewed {
+			return apperr.BadRequest("no review with this food after the recommendation", nil)
+		}
+	}
+
+	event := &models.RecEvent{
+		ID:               primitive.NewObjectID(),
+		RecommendationID: recID,
+		UserID:           uID,
+		FoodID:           fID,
+		Type:             req.Type,
+		CreatedAt:        now,
+	}
+	err = s.recEventRepo.Create(ctx, event)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		return apperr.InternalServerError("failed to save recommendation event", err)
+	}
+
+	// 노출과 같은 날짜 버킷에 반응을 더해야 클릭률이 맞음.
+	// 집계에 실패하면 이벤트를 되돌려서 재시도가 중복으로 무시되지 않도록 함
+	err = s.recEventRepo.IncrementReaction(ctx, uID, fID, req.Type, history.CreatedAt)
+	if err != nil {
+		if delErr := s.recEventRepo.Delete(ctx, event.ID); delErr != nil {
+			log.Printf("[WARNING] failed to roll back recommendation event %s: %v", event.ID.Hex(), delErr)
+		}
+		return apperr.InternalServerError("failed to count recommendation event", err)
+	}
+
+	return nil
+}
+
+func (s *recommendationService) GetCTR(ctx context.Context, scope string, days int, count int) ([]models.CTRStat, error) {
+	if scope != models.RecStatScopeFood && scope != models.RecStatScopeUser {
+		return nil, apperr.BadRequest("invalid scope", nil)
+	}
+
+	maxDays := int(models.RecEventRetention.Hours() / 24)
+	if days <= 0 || days > maxDays {
+		return nil, apperr.BadRequest("invalid days", nil)
+	}
+
+	if count <= 0 || count > 100 {
+		return nil, apperr.BadRequest("invalid count", nil)
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+	stats, err := s.recEventRepo.AggregateCTR(ctx, scope, nil, since, count)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to aggregate CTR", err)
+	}
+
+	return stats, nil
+}
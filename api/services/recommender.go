@@ -0,0 +1,793 @@
+// api/services/recommender.go
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/config"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const RecommenderDecay = "decay"
+
+// 후보를 모아 점수를 매기고 Count개를 고르는 추천 전략
+type Recommender interface {
+	Name() string
+	Recommend(ctx context.Context, req RecommendRequest) ([]Recommendation, error)
+	RecommendGroup(ctx context.Context, req GroupRecommendRequest) ([]Recommendation, error)
+}
+
+// 추천된 음식과 점수를 매길 때 영향을 준 이유
+type Recommendation struct {
+	Food    models.StandardFood
+	Reasons []models.RecReason
+}
+
+// 이 정도 이상 영향을 줬을 때만 이유로 보여줌
+const (
+	reasonMinTasteAffinity = 0.3
+	reasonMinCTRMultiplier = 1.1
+	reasonMinCFScore       = 0.2
+	reasonMinMealTimeScore = 1.1
+)
+
+type RecommendRequest struct {
+	UserID primitive.ObjectID
+	Filter repositories.StandardFoodFilter
+	Count  int
+
+	// 후보를 가져오는 방법. 없으면 Filter 조건에서 랜덤으로 뽑음
+	Source func(ctx context.Context, limit int) ([]models.StandardFood, error)
+
+	// 후보별 기본 점수. 없으면 1
+	BaseScore func(models.StandardFood) float64
+
+	// 식사 시간대 (models.MealTime*). 비어 있으면 시간대 적합도를 반영하지 않음
+	MealTime string
+}
+
+// 여러 사용자가 함께 먹을 음식 추천 요청. Filter에는 모든 멤버의 식단 조건이 합쳐져 있어야 함
+type GroupRecommendRequest struct {
+	UserIDs  []primitive.ObjectID
+	Filter   repositories.StandardFoodFilter
+	Count    int
+	MealTime string
+}
+
+// 설정만 바꿔 추천 전략을 만드는 함수. 실험 변형마다 다른 설정으로 만들 때 사용
+type RecommenderFactory func(cfg config.RecommenderConfig) (Recommender, error)
+
+func NewRecommenderFactory(
+	fr repositories.FoodRepository,
+	rhr repositories.RecHistoryRepository,
+	rer repositories.RecEventRepository,
+	tr repositories.TasteRepository,
+	nr repositories.NeighborRepository,
+	lr repositories.LikeRepository,
+	rr repositories.ReviewRepository,
+	mtr repositories.MealTimeRepository,
+) RecommenderFactory {
+	return func(cfg config.RecommenderConfig) (Recommender, error) {
+		if !isKnownRecommender(cfg.Strategy) {
+			return nil, fmt.Errorf("unknown recommender strategy: %s", cfg.Strategy)
+		}
+		return &decayRecommender{
+			foodRepo:       fr,
+			recHistoryRepo: rhr,
+			recEventRepo:   rer,
+			tasteRepo:      tr,
+			neighborRepo:   nr,
+			likeRepo:       lr,
+			reviewRepo:     rr,
+			mealTimeRepo:   mtr,
+			cfg:            cfg,
+		}, nil
+	}
+}
+
+func isKnownRecommender(strategy string) bool {
+	return strategy == "" || strategy == RecommenderDecay
+}
+
+// 최근 추천 감쇠, 클릭률, 취향, 랜덤 가중치, 부모 다양성을 적용하는 기본 전략
+type decayRecommender struct {
+	foodRepo       repositories.FoodRepository
+	recHistoryRepo repositories.RecHistoryRepository
+	recEventRepo   repositories.RecEventRepository
+	tasteRepo      repositories.TasteRepository
+	neighborRepo   repositories.NeighborRepository
+	likeRepo       repositories.LikeRepository
+	reviewRepo     repositories.ReviewRepository
+	mealTimeRepo   repositories.MealTimeRepository
+	cfg            config.RecommenderConfig
+}
+
+func (r *decayRecommender) Name() string {
+	return RecommenderDecay
+}
+
+func (r *decayRecommender) Recommend(ctx context.Context, req RecommendRequest) ([]Recommendation, error) {
+	limit := req.Count * max(r.cfg.CandidateMultiplier, 1)
+
+	var candidates []models.StandardFood
+	var cfScores map[primitive.ObjectID]float64
+	var err error
+	if req.Source != nil {
+		candidates, err = req.Source(ctx, limit)
+	} else {
+		candidates, err = r.foodRepo.GetRandomStandards(ctx, req.Filter, limit)
+		if err == nil {
+			candidates, cfScores = r.addCollaborativeCandidates(ctx, req, candidates)
+		}
+	}
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to get candidate foods", err)
+	}
+	if req.Source != nil {
+		candidates = excludeHidden(candidates, req.Filter)
+	}
+
+	historyMap, err := r.recHistoryRepo.GetRecentFoodIDsMap(ctx, req.UserID, r.cfg.HistoryDays)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to get recommendation history", err)
+	}
+
+	meals := r.recentMeals(ctx, []primitive.ObjectID{req.UserID})
+	ctrMultipliers := r.ctrMultipliers(ctx, candidates)
+	mealTimeMultipliers := r.mealTimeMultipliers(ctx, candidates, req.MealTime)
+	profile := r.tasteProfile(ctx, req.UserID)
+
+	scoredList := make([]scoredFood, 0, len(candidates))
+	now := time.Now()
+
+	for _, food := range candidates {
+		var reasons []models.RecReason
+
+		weight := 1.0
+		if lastSeen, ok := historyMap[food.ID]; ok {
+			weight = r.decayWeight(now.Sub(lastSeen))
+		}
+
+		weight *= meals.weight(food, now, r.ateDecayWeight)
+
+		if cf, ok := cfScores[food.ID]; ok {
+			weight *= 1 + r.cfg.CFWeight*cf
+			if cf >= reasonMinCFScore {
+				reasons = append(reasons, models.RecReason{Code: models.RecReasonSimilarToLiked})
+			}
+		}
+
+		if req.BaseScore != nil {
+			weight *= req.BaseScore(food)
+		}
+
+		if m, ok := mealTimeMultipliers[food.ID]; ok {
+			weight *= m
+			if m >= reasonMinMealTimeScore {
+				reasons = append(reasons, models.RecReason{Code: models.RecReasonFitsMealTime, Detail: req.MealTime})
+			}
+		}
+
+		weight *= 1 - r.cfg.Jitter + rand.Float64()*2*r.cfg.Jitter
+
+		tasteWeight := 1.0
+		if affinity, ok := profile.Affinity(food); ok {
+			tasteWeight = math.Max(1+r.cfg.TasteWeight*affinity, 0.05)
+			if category := profile.FavoriteCategory(food); affinity >= reasonMinTasteAffinity && category != "" {
+				reasons = append(reasons, models.RecReason{Code: models.RecReasonMatchesTaste, Detail: category})
+			}
+		} else if profile != nil && len(food.Categories) > 0 {
+			reasons = append(reasons, models.RecReason{Code: models.RecReasonNewCategory, Detail: food.Categories[0]})
+		}
+
+		if m, ok := ctrMultipliers[food.ID]; ok {
+			weight *= m
+			if m >= reasonMinCTRMultiplier {
+				reasons = append(reasons, models.RecReason{Code: models.RecReasonPopular, Days: r.cfg.CTRDays})
+			}
+		}
+
+		// 추천 기록이 있는 사용자에게만 의미가 있음
+		var fallback *models.RecReason
+		if _, seen := historyMap[food.ID]; !seen && len(historyMap) > 0 && r.cfg.HistoryDays > 0 {
+			fallback = &models.RecReason{Code: models.RecReasonNotShownRecently, Days: r.cfg.HistoryDays}
+		}
+
+		scoredList = append(scoredList, scoredFood{
+			food:         food,
+			score:        weight * tasteWeight,
+			exploreScore: weight,
+			reasons:      reasons,
+			fallback:     fallback,
+		})
+	}
+
+	excludedParents, err := r.recHistoryRepo.GetLatestParents(ctx, req.UserID, r.cfg.DiversityDays)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to get latest parents from history", err)
+	}
+
+	usedParents := make(map[string]bool)
+	for _, parent := range excludedParents {
+		usedParents[parent] = true
+	}
+	for parent, ateAt := range meals.parents {
+		if now.Sub(ateAt) < r.cfg.AteDiversityWindow {
+			usedParents[parent] = true
+		}
+	}
+
+	picker := newFoodPicker(usedParents)
+
+	// 취향 프로필이 있으면 Exploration 비율만큼은 취향을 뺀 점수로 골라 좋아하는 음식만 나오지 않게 함
+	exploreSlots := 0
+	if profile != nil {
+		exploreSlots = int(math.Round(float64(req.Count) * r.cfg.Exploration))
+	}
+
+	sort.Slice(scoredList, func(i, j int) bool {
+		return scoredList[i].score > scoredList[j].score
+	})
+	picker.pick(scoredList, req.Count-exploreSlots, true)
+
+	if exploreSlots > 0 {
+		exploreList := make([]scoredFood, len(scoredList))
+		copy(exploreList, scoredList)
+		sort.Slice(exploreList, func(i, j int) bool {
+			return exploreList[i].exploreScore > exploreList[j].exploreScore
+		})
+		picker.pick(exploreList, req.Count, true)
+	}
+
+	// 혹시라도 부족할 시 부모 상관없이 채우기
+	picker.pick(scoredList, req.Count, false)
+
+	return picker.results, nil
+}
+
+// 멤버 누구에게든 최근 추천됐거나 최근 먹은 음식은 후보에서 빼고, 멤버별 취향 가중치의 평균에
+// 가장 낮은 가중치의 제곱근을 곱해 한 명이라도 싫어하는 음식은 뒤로 밀리게 함
+func (r *decayRecommender) RecommendGroup(ctx context.Context, req GroupRecommendRequest) ([]Recommendation, error) {
+	shown, err := r.recHistoryRepo.GetRecentFoodIDsMapForUsers(ctx, req.UserIDs, r.cfg.HistoryDays)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to get recommendation history", err)
+	}
+	meals := r.recentMeals(ctx, req.UserIDs)
+
+	filter := req.Filter
+	filter.ExcludeIDs = make([]primitive.ObjectID, 0, len(req.Filter.ExcludeIDs)+len(shown)+len(meals.foods))
+	filter.ExcludeIDs = append(filter.ExcludeIDs, req.Filter.ExcludeIDs...)
+	for id := range shown {
+		filter.ExcludeIDs = append(filter.ExcludeIDs, id)
+	}
+	for id := range meals.foods {
+		filter.ExcludeIDs = append(filter.ExcludeIDs, id)
+	}
+
+	limit := req.Count * max(r.cfg.CandidateMultiplier, 1)
+	candidates, err := r.foodRepo.GetRandomStandards(ctx, filter, limit)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to get candidate foods", err)
+	}
+
+	ctrMultipliers := r.ctrMultipliers(ctx, candidates)
+	mealTimeMultipliers := r.mealTimeMultipliers(ctx, candidates, req.MealTime)
+	profiles := r.tasteProfiles(ctx, req.UserIDs)
+
+	scoredList := make([]scoredFood, 0, len(candidates))
+	now := time.Now()
+
+	for _, food := range candidates {
+		var reasons []models.RecReason
+
+		weight := meals.weight(food, now, r.ateDecayWeight)
+
+		if m, ok := mealTimeMultipliers[food.ID]; ok {
+			weight *= m
+			if m >= reasonMinMealTimeScore {
+				reasons = append(reasons, models.RecReason{Code: models.RecReasonFitsMealTime, Detail: req.MealTime})
+			}
+		}
+
+		if m, ok := ctrMultipliers[food.ID]; ok {
+			weight *= m
+			if m >= reasonMinCTRMultiplier {
+				reasons = append(reasons, models.RecReason{Code: models.RecReasonPopular, Days: r.cfg.CTRDays})
+			}
+		}
+
+		weight *= 1 - r.cfg.Jitter + rand.Float64()*2*r.cfg.Jitter
+
+		sum, minWeight, fans := 0.0, math.Inf(1), 0
+		for _, userID := range req.UserIDs {
+			memberWeight := 1.0
+			if affinity, ok := profiles[userID].Affinity(food); ok {
+				memberWeight = math.Max(1+r.cfg.TasteWeight*affinity, 0.05)
+				if affinity >= reasonMinTasteAffinity {
+					fans++
+				}
+			}
+			sum += memberWeight
+			minWeight = math.Min(minWeight, memberWeight)
+		}
+		weight *= sum / float64(len(req.UserIDs)) * math.Sqrt(minWeight)
+
+		if fans > 0 {
+			reasons = append([]models.RecReason{{Code: models.RecReasonGroupMatch, Count: fans}}, reasons...)
+		}
+
+		scoredList = append(scoredList, scoredFood{
+			food:    food,
+			score:   weight,
+			reasons: reasons,
+		})
+	}
+
+	// 멤버가 최근 먹은 부모도 되도록 피함
+	usedParents := make(map[string]bool)
+	for parent, ateAt := range meals.parents {
+		if now.Sub(ateAt) < r.cfg.AteDiversityWindow {
+			usedParents[parent] = true
+		}
+	}
+	picker := newFoodPicker(usedParents)
+
+	sort.Slice(scoredList, func(i, j int) bool {
+		return scoredList[i].score > scoredList[j].score
+	})
+	picker.pick(scoredList, req.Count, true)
+	picker.pick(scoredList, req.Count, false)
+
+	return picker.results, nil
+}
+
+// exploreScore는 취향을 빼고 매긴 점수
+type scoredFood struct {
+	food         models.StandardFood
+	score        float64
+	exploreScore float64
+	reasons      []models.RecReason
+	// 기록이 있는 사용자에게는 대부분의 음식이 해당되는 이유라 reasons가 비었을 때만 붙임
+	fallback *models.RecReason
+}
+
+// 최근 추천된(또는 이미 고른) 부모와 겹치지 않게 점수 순으로 고름
+type foodPicker struct {
+	results     []Recommendation
+	picked      map[primitive.ObjectID]bool
+	usedParents map[string]bool
+}
+
+func newFoodPicker(usedParents map[string]bool) *foodPicker {
+	return &foodPicker{
+		picked:      make(map[primitive.ObjectID]bool),
+		usedParents: usedParents,
+	}
+}
+
+// 전체 결과가 limit개가 될 때까지 채움
+func (p *foodPicker) pick(list []scoredFood, limit int, checkParents bool) {
+	for _, sf := range list {
+		if len(p.results) >= limit {
+			break
+		}
+		if p.picked[sf.food.ID] {
+			continue
+		}
+
+		if checkParents {
+			isOverlap := false
+			for _, parent := range sf.food.Parents {
+				if p.usedParents[parent] {
+					isOverlap = true
+					break
+				}
+			}
+			if isOverlap {
+				continue
+			}
+		}
+
+		reasons := sf.reasons
+		if len(reasons) == 0 && sf.fallback != nil {
+			reasons = []models.RecReason{*sf.fallback}
+		}
+		p.results = append(p.results, Recommendation{Food: sf.food, Reasons: reasons})
+		p.picked[sf.food.ID] = true
+		for _, parent := range sf.food.Parents {
+			p.usedParents[parent] = true
+		}
+	}
+}
+
+// Source가 직접 후보를 가져올 때는 숨김 조건이 쿼리에 빠져 있을 수 있어 한 번 더 거름
+func excludeHidden(candidates []models.StandardFood, filter repositories.StandardFoodFilter) []models.StandardFood {
+	if len(filter.ExcludeIDs) == 0 && len(filter.ExcludeParents) == 0 {
+		return candidates
+	}
+
+	hiddenIDs := make(map[primitive.ObjectID]bool, len(filter.ExcludeIDs))
+	for _, id := range filter.ExcludeIDs {
+		hiddenIDs[id] = true
+	}
+	hiddenParents := make(map[string]bool, len(filter.ExcludeParents))
+	for _, parent := range filter.ExcludeParents {
+		hiddenParents[parent] = true
+	}
+
+	visible := candidates[:0]
+	for _, food := range candidates {
+		if hiddenIDs[food.ID] {
+			continue
+		}
+		hidden := false
+		for _, parent := range food.Parents {
+			if hiddenParents[parent] {
+				hidden = true
+				break
+			}
+		}
+		if !hidden {
+			visible = append(visible, food)
+		}
+	}
+	return visible
+}
+
+// 마지막 구간보다 오래됐으면 DecayFloor
+func (r *decayRecommender) decayWeight(sinceSeen time.Duration) float64 {
+	for _, bucket := range r.cfg.DecayBuckets {
+		if sinceSeen < bucket.Within {
+			return bucket.Weight
+		}
+	}
+	return r.cfg.DecayFloor
+}
+
+func (r *decayRecommender) ateDecayWeight(sinceAte time.Duration) float64 {
+	for _, bucket := range r.cfg.AteDecayBuckets {
+		if sinceAte < bucket.Within {
+			return bucket.Weight
+		}
+	}
+	return r.cfg.AteDecayFloor
+}
+
+// 최근 리뷰로 남긴 음식과 부모별 마지막으로 먹은 시각
+type mealHistory struct {
+	foods   map[primitive.ObjectID]time.Time
+	parents map[string]time.Time
+}
+
+// 먹은 음식 자체는 감쇠 가중치를, 같은 부모만 겹치면 더 약하게 제곱근을 적용
+func (m mealHistory) weight(food models.StandardFood, now time.Time, decay func(time.Duration) float64) float64 {
+	if ateAt, ok := m.foods[food.ID]; ok {
+		return decay(now.Sub(ateAt))
+	}
+
+	weight := 1.0
+	for _, parent := range food.Parents {
+		if ateAt, ok := m.parents[parent]; ok {
+			weight = math.Min(weight, math.Sqrt(decay(now.Sub(ateAt))))
+		}
+	}
+	return weight
+}
+
+// 후보 수나 인원과 상관없이 최근 리뷰 한 번, 음식 정보 한 번만 조회. 부가 신호라서 조회에 실패하면 반영하지 않음
+func (r *decayRecommender) recentMeals(ctx context.Context, userIDs []primitive.ObjectID) mealHistory {
+	var meals mealHistory
+	if r.cfg.AteDays <= 0 || r.reviewRepo == nil {
+		return meals
+	}
+
+	since := time.Now().AddDate(0, 0, -r.cfg.AteDays)
+	reviews, err := r.reviewRepo.FindByUserIDsSince(ctx, userIDs, since, 100*len(userIDs))
+	if err != nil {
+		log.Printf("[WARNING] failed to load recent reviews: %v", err)
+		return meals
+	}
+
+	// 최근 리뷰부터 오므로 처음 나온 시각이 마지막으로 먹은 시각
+	meals.foods = make(map[primitive.ObjectID]time.Time)
+	var foodIDs []primitive.ObjectID
+	for _, review := range reviews {
+		for _, item := range review.Foods {
+			if item.Type != models.FoodTypeStandard {
+				continue
+			}
+			fID, err := primitive.ObjectIDFromHex(item.FoodID)
+			if err != nil {
+				continue
+			}
+			if _, ok := meals.foods[fID]; !ok {
+				meals.foods[fID] = review.CreatedAt
+				foodIDs = append(foodIDs, fID)
+			}
+		}
+	}
+	if len(foodIDs) == 0 {
+		return meals
+	}
+
+	foods, err := r.foodRepo.FindStandardByIDs(ctx, foodIDs)
+	if err != nil {
+		log.Printf("[WARNING] failed to load recently eaten foods: %v", err)
+		return meals
+	}
+
+	meals.parents = make(map[string]time.Time)
+	for _, food := range foods {
+		ateAt := meals.foods[food.ID]
+		for _, parent := range food.Parents {
+			if last, ok := meals.parents[parent]; !ok || ateAt.After(last) {
+				meals.parents[parent] = ateAt
+			}
+		}
+	}
+	return meals
+}
+
+// 음식별 클릭률을 CTRPrior 노출만큼 전체 평균 쪽으로 보정한 뒤, 평균 대비 배수에 CTRWeight 제곱을 적용.
+// 부가 신호라서 조회에 실패하면 클릭률 없이 추천함
+func (r *decayRecommender) ctrMultipliers(ctx context.Context, candidates []models.StandardFood) map[primitive.ObjectID]float64 {
+	if r.cfg.CTRWeight <= 0 || r.recEventRepo == nil || len(candidates) == 0 {
+		return nil
+	}
+
+	since := time.Now().AddDate(0, 0, -r.cfg.CTRDays)
+	global, err := r.recEventRepo.AggregateCTR(ctx, models.RecStatScopeGlobal, nil, since, 1)
+	if err != nil {
+		log.Printf("[WARNING] failed to aggregate global CTR: %v", err)
+		return nil
+	}
+	if len(global) == 0 || global[0].Clicks == 0 {
+		return nil
+	}
+	globalCTR := global[0].CTR
+
+	foodIDs := make([]primitive.ObjectID, 0, len(candidates))
+	for _, food := range candidates {
+		foodIDs = append(foodIDs, food.ID)
+	}
+	stats, err := r.recEventRepo.AggregateCTR(ctx, models.RecStatScopeFood, foodIDs, since, 0)
+	if err != nil {
+		log.Printf("[WARNING] failed to aggregate food CTR: %v", err)
+		return nil
+	}
+
+	prior := float64(max(r.cfg.CTRPrior, 1))
+	multipliers := make(map[primitive.ObjectID]float64, len(stats))
+	for _, stat := range stats {
+		smoothed := (float64(stat.Clicks) + prior*globalCTR) / (float64(stat.Impressions) + prior)
+		multipliers[stat.TargetID] = math.Pow(smoothed/globalCTR, r.cfg.CTRWeight)
+	}
+	return multipliers
+}
+
+// 음식의 시간대 적합도가 있으면 그것을, 없으면 부모들의 평균을 MealTimeWeight 제곱해 0.2 ~ 3으로 제한.
+// 부가 신호라서 조회에 실패하면 시간대 없이 추천함
+func (r *decayRecommender) mealTimeMultipliers(ctx context.Context, candidates []models.StandardFood, mealTime string) map[primitive.ObjectID]float64 {
+	if mealTime == "" || r.cfg.MealTimeWeight <= 0 || r.mealTimeRepo == nil || len(candidates) == 0 {
+		return nil
+	}
+
+	foodIDs := make([]string, 0, len(candidates))
+	parentSet := make(map[string]bool)
+	for _, food := range candidates {
+		foodIDs = append(foodIDs, food.ID.Hex())
+		for _, parent := range food.Parents {
+			parentSet[parent] = true
+		}
+	}
+	parents := make([]string, 0, len(parentSet))
+	for parent := range parentSet {
+		parents = append(parents, parent)
+	}
+
+	foodRows, err := r.mealTimeRepo.FindByTargets(ctx, models.MealTimeScopeFood, foodIDs)
+	if err != nil {
+		log.Printf("[WARNING] failed to load food meal time suitability: %v", err)
+		return nil
+	}
+	parentRows, err := r.mealTimeRepo.FindByTargets(ctx, models.MealTimeScopeParent, parents)
+	if err != nil {
+		log.Printf("[WARNING] failed to load parent meal time suitability: %v", err)
+		return nil
+	}
+
+	foodWeights := make(map[string]float64, len(foodRows))
+	for _, row := range foodRows {
+		if w, ok := row.Weights[mealTime]; ok {
+			foodWeights[row.Target] = w
+		}
+	}
+	parentWeights := make(map[string]float64, len(parentRows))
+	for _, row := range parentRows {
+		if w, ok := row.Weights[mealTime]; ok {
+			parentWeights[row.Target] = w
+		}
+	}
+
+	multipliers := make(map[primitive.ObjectID]float64)
+	for _, food := range candidates {
+		suitability, ok := foodWeights[food.ID.Hex()]
+		if !ok {
+			sum, n := 0.0, 0
+			for _, parent := range food.Parents {
+				if w, ok := parentWeights[parent]; ok {
+					sum += w
+					n++
+				}
+			}
+			if n == 0 {
+				continue
+			}
+			suitability = sum / float64(n)
+		}
+		multipliers[food.ID] = math.Min(math.Max(math.Pow(suitability, r.cfg.MealTimeWeight), 0.2), 3)
+	}
+	return multipliers
+}
+
+// 부가 신호라서 조회에 실패하면 취향 없이 추천함
+func (r *decayRecommender) tasteProfile(ctx context.Context, userID primitive.ObjectID) *models.TasteProfile {
+	if r.cfg.TasteWeight <= 0 || r.tasteRepo == nil {
+		return nil
+	}
+
+	profile, err := r.tasteRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		log.Printf("[WARNING] failed to load taste profile: %v", err)
+		return nil
+	}
+	return profile
+}
+
+// 부가 신호라서 조회에 실패하면 모두 취향 없이 추천함
+func (r *decayRecommender) tasteProfiles(ctx context.Context, userIDs []primitive.ObjectID) map[primitive.ObjectID]*models.TasteProfile {
+	if r.cfg.TasteWeight <= 0 || r.tasteRepo == nil {
+		return nil
+	}
+
+	profiles, err := r.tasteRepo.FindByUserIDs(ctx, userIDs)
+	if err != nil {
+		log.Printf("[WARNING] failed to load taste profiles: %v", err)
+		return nil
+	}
+
+	profileMap := make(map[primitive.ObjectID]*models.TasteProfile, len(profiles))
+	for i := range profiles {
+		profileMap[profiles[i].UserID] = &profiles[i]
+	}
+	return profileMap
+}
+
+// 사용자가 최근 좋아요하거나 높게 평가한 음식의 이웃을 "비슷한 사용자들이 좋아한" 후보로 랜덤 후보에 더함.
+// 이웃 점수는 0 ~ 1로 맞춰 반환하며, 부가 신호라서 조회에 실패하면 랜덤 후보만 사용함
+func (r *decayRecommender) addCollaborativeCandidates(ctx context.Context, req RecommendRequest, candidates []models.StandardFood) ([]models.StandardFood, map[primitive.ObjectID]float64) {
+	if r.cfg.CFCandidates <= 0 || r.neighborRepo == nil {
+		return candidates, nil
+	}
+
+	seeds, err := r.preferenceSeeds(ctx, req.UserID)
+	if err != nil {
+		log.Printf("[WARNING] failed to load collaborative filtering seeds: %v", err)
+		return candidates, nil
+	}
+	if len(seeds) == 0 {
+		return candidates, nil
+	}
+
+	seedIDs := make([]primitive.ObjectID, 0, len(seeds))
+	for id := range seeds {
+		seedIDs = append(seedIDs, id)
+	}
+	neighbors, err := r.neighborRepo.FindByFoodIDs(ctx, seedIDs)
+	if err != nil {
+		log.Printf("[WARNING] failed to load food neighbors: %v", err)
+		return candidates, nil
+	}
+
+	scores := make(map[primitive.ObjectID]float64)
+	for _, n := range neighbors {
+		for _, entry := range n.Neighbors {
+			if _, isSeed := seeds[entry.FoodID]; isSeed {
+				continue
+			}
+			scores[entry.FoodID] += entry.Similarity * seeds[n.FoodID]
+		}
+	}
+	if len(scores) == 0 {
+		return candidates, nil
+	}
+
+	type scoredID struct {
+		id    primitive.ObjectID
+		score float64
+	}
+	ranked := make([]scoredID, 0, len(scores))
+	for id, score := range scores {
+		ranked = append(ranked, scoredID{id: id, score: score})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+	if limit := req.Count * r.cfg.CFCandidates; len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(ranked))
+	for _, s := range ranked {
+		ids = append(ids, s.id)
+	}
+	// 식단 프로필 등 요청 조건에 맞는 것만 후보로 사용
+	foods, err := r.foodRepo.FindStandardsByIDsAndFilter(ctx, ids, req.Filter)
+	if err != nil {
+		log.Printf("[WARNING] failed to load collaborative filtering candidates: %v", err)
+		return candidates, nil
+	}
+
+	maxScore := ranked[0].score
+	cfScores := make(map[primitive.ObjectID]float64, len(foods))
+	seen := make(map[primitive.ObjectID]bool, len(candidates))
+	for _, food := range candidates {
+		seen[food.ID] = true
+	}
+	for _, food := range foods {
+		cfScores[food.ID] = scores[food.ID] / maxScore
+		if !seen[food.ID] {
+			candidates = append(candidates, food)
+			seen[food.ID] = true
+		}
+	}
+
+	return candidates, cfScores
+}
+
+// 최근 좋아요는 1, 최근 90일 리뷰 중 평점 4는 0.5, 5는 1
+func (r *decayRecommender) preferenceSeeds(ctx context.Context, userID primitive.ObjectID) (map[primitive.ObjectID]float64, error) {
+	likes, err := r.likeRepo.FindRecentByUserID(ctx, userID, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := r.reviewRepo.FindByUserIDSince(ctx, userID, time.Now().AddDate(0, 0, -90), 50)
+	if err != nil {
+		return nil, err
+	}
+
+	seeds := make(map[primitive.ObjectID]float64)
+	for _, like := range likes {
+		seeds[like.FoodID] = 1
+	}
+	for _, review := range reviews {
+		if review.Rating < neighborMinRating {
+			continue
+		}
+		weight := float64(review.Rating-3) / 2
+		for _, item := range review.Foods {
+			if item.Type != models.FoodTypeStandard {
+				continue
+			}
+			fID, err := primitive.ObjectIDFromHex(item.FoodID)
+			if err != nil {
+				continue
+			}
+			if weight > seeds[fID] {
+				seeds[fID] = weight
+			}
+		}
+	}
+	return seeds, nil
+}
@@ -4,11 +4,13 @@ package services
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/seojoonrp/bapddang-server/api/repositories"
 	"github.com/seojoonrp/bapddang-server/apperr"
 	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/storage"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -25,17 +27,32 @@ type reviewService struct {
 	foodRepo        repositories.FoodRepository
 	userRepo        repositories.UserRepository
 	marshmallowRepo repositories.MarshmallowRepository
+	blobStore       storage.BlobStore
+	tasteProfiler   TasteProfiler
 }
 
-func NewReviewService(rr repositories.ReviewRepository, fr repositories.FoodRepository, ur repositories.UserRepository, mr repositories.MarshmallowRepository) ReviewService {
+func NewReviewService(rr repositories.ReviewRepository, fr repositories.FoodRepository, ur repositories.UserRepository, mr repositories.MarshmallowRepository, bs storage.BlobStore, tp TasteProfiler) ReviewService {
 	return &reviewService{
 		reviewRepo:      rr,
 		foodRepo:        fr,
 		userRepo:        ur,
 		marshmallowRepo: mr,
+		blobStore:       bs,
+		tasteProfiler:   tp,
 	}
 }
 
+// 리뷰 이미지는 업로드 API로 이 서버의 저장소에 올린 것만 허용
+func (s *reviewService) validateImageURL(imageURL string) error {
+	if imageURL == "" {
+		return nil
+	}
+	if !strings.HasPrefix(imageURL, s.blobStore.BaseURL()+"/") || strings.Contains(imageURL, "..") {
+		return apperr.BadRequest("imageURL must be an uploaded image", nil)
+	}
+	return nil
+}
+
 func (s *reviewService) classifyFoodItems(foods []models.ReviewFoodItem) ([]primitive.ObjectID, []primitive.ObjectID) {
 	var standards, customs []primitive.ObjectID
 	for _, f := range foods {
@@ -66,11 +83,24 @@ func (s *reviewService) Create(ctx context.Context, req models.CreateReviewReque
 		return nil, apperr.BadRequest("rating must be between 1 and 5", nil)
 	}
 
+	for _, f := range req.Foods {
+		if f.Portion < 0 || f.Portion > 10 {
+			return nil, apperr.BadRequest("portion must be between 0 and 10", nil)
+		}
+		if f.Type == models.FoodTypeStandard && !primitive.IsValidObjectID(f.FoodID) {
+			return nil, apperr.BadRequest("invalid standard food ID format", nil)
+		}
+	}
+
 	commentLen := len([]rune(req.Comment))
 	if commentLen > 50 {
 		return nil, apperr.BadRequest("comment must be less than 50 characters", nil)
 	}
 
+	if err := s.validateImageURL(req.ImageURL); err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepo.FindByID(ctx, uID)
 	if err != nil {
 		return nil, apperr.InternalServerError("failed to fetch user", err)
@@ -118,6 +148,10 @@ func (s *reviewService) Create(ctx context.Context, req models.CreateReviewReque
 		}
 	}
 
+	if len(standardFoodIDs) > 0 {
+		s.tasteProfiler.RefreshAsync(uID)
+	}
+
 	reqWeek := (req.Day-1)/7 + 1
 	if reqWeek == user.Week {
 		marshmallow, err := s.marshmallowRepo.FindByUserIDAndWeek(ctx, user.ID, user.Week)
@@ -163,6 +197,13 @@ func (s *reviewService) Update(ctx context.Context, reviewID string, userID stri
 		return nil, apperr.BadRequest("comment must be less than 50 characters", nil)
 	}
 
+	// 이 검사가 생기기 전에 저장된 이미지는 바꾸지 않는 한 그대로 둠
+	if req.ImageURL != review.ImageURL {
+		if err := s.validateImageURL(req.ImageURL); err != nil {
+			return nil, err
+		}
+	}
+
 	user, err := s.userRepo.FindByID(ctx, review.UserID)
 	if err != nil {
 		return nil, apperr.InternalServerError("failed to fetch user", err)
@@ -188,6 +229,7 @@ func (s *reviewService) Update(ctx context.Context, reviewID string, userID stri
 		if err != nil {
 			return nil, apperr.InternalServerError("failed to update food review stats", err)
 		}
+		s.tasteProfiler.RefreshAsync(review.UserID)
 	}
 
 	if review.Week == user.Week && oldRating != review.Rating {
@@ -264,6 +306,10 @@ func (s *reviewService) Delete(ctx context.Context, reviewID string, userID stri
 		return apperr.InternalServerError("failed to delete review", err)
 	}
 
+	if len(standardFoodIDs) > 0 {
+		s.tasteProfiler.RefreshAsync(review.UserID)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,157 @@
+// api/services/taste.go
+
+package services
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/api/repositories"
+	"github.com/seojoonrp/bapddang-server/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// 오래된 좋아요/리뷰일수록 취향에 덜 반영
+	tasteHalfLife = 90 * 24 * time.Hour
+	// 신호 합이 이 정도면 선호도가 약 0.76
+	tasteScale = 2.0
+	// 너무 약한 선호도는 저장하지 않음
+	tasteMinAffinity = 0.01
+)
+
+// 좋아요/리뷰가 바뀔 때마다 사용자의 취향 프로필을 다시 계산
+type TasteProfiler interface {
+	Refresh(ctx context.Context, userID primitive.ObjectID) error
+	RefreshAsync(userID primitive.ObjectID)
+}
+
+type tasteProfiler struct {
+	tasteRepo  repositories.TasteRepository
+	likeRepo   repositories.LikeRepository
+	reviewRepo repositories.ReviewRepository
+	foodRepo   repositories.FoodRepository
+}
+
+func NewTasteProfiler(
+	tr repositories.TasteRepository,
+	lr repositories.LikeRepository,
+	rr repositories.ReviewRepository,
+	fr repositories.FoodRepository,
+) TasteProfiler {
+	return &tasteProfiler{
+		tasteRepo:  tr,
+		likeRepo:   lr,
+		reviewRepo: rr,
+		foodRepo:   fr,
+	}
+}
+
+// 좋아요는 +1, 리뷰는 평점 3을 0으로 두고 -1 ~ +1을 음식의 카테고리와 부모에 더한 뒤 tanh로 눌러 -1 ~ 1로 맞춤
+func (p *tasteProfiler) Refresh(ctx context.Context, userID primitive.ObjectID) error {
+	likes, err := p.likeRepo.FindLikesByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	reviews, err := p.reviewRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	type signal struct {
+		foodID primitive.ObjectID
+		weight float64
+	}
+	now := time.Now()
+	decay := func(at time.Time) float64 {
+		return math.Pow(0.5, float64(now.Sub(at))/float64(tasteHalfLife))
+	}
+
+	signals := make([]signal, 0, len(likes)+len(reviews))
+	for _, like := range likes {
+		signals = append(signals, signal{foodID: like.FoodID, weight: decay(like.CreatedAt)})
+	}
+	for _, review := range reviews {
+		weight := float64(review.Rating-3) / 2 * decay(review.CreatedAt)
+		if weight == 0 {
+			continue
+		}
+		for _, item := range review.Foods {
+			if item.Type != models.FoodTypeStandard {
+				continue
+			}
+			fID, err := primitive.ObjectIDFromHex(item.FoodID)
+			if err != nil {
+				continue
+			}
+			signals = append(signals, signal{foodID: fID, weight: weight})
+		}
+	}
+
+	foodIDs := make([]primitive.ObjectID, 0, len(signals))
+	seen := make(map[primitive.ObjectID]bool)
+	for _, s := range signals {
+		if !seen[s.foodID] {
+			seen[s.foodID] = true
+			foodIDs = append(foodIDs, s.foodID)
+		}
+	}
+
+	foodMap := make(map[primitive.ObjectID]*models.StandardFood)
+	if len(foodIDs) > 0 {
+		foods, err := p.foodRepo.FindStandardByIDs(ctx, foodIDs)
+		if err != nil {
+			return err
+		}
+		for _, food := range foods {
+			foodMap[food.ID] = food
+		}
+	}
+
+	categorySums := make(map[string]float64)
+	parentSums := make(map[string]float64)
+	for _, s := range signals {
+		food, ok := foodMap[s.foodID]
+		if !ok {
+			continue
+		}
+		for _, category := range food.Categories {
+			categorySums[category] += s.weight
+		}
+		for _, parent := range food.Parents {
+			parentSums[parent] += s.weight
+		}
+	}
+
+	return p.tasteRepo.Upsert(ctx, models.TasteProfile{
+		UserID:      userID,
+		Categories:  squashAffinities(categorySums),
+		Parents:     squashAffinities(parentSums),
+		SignalCount: len(signals),
+		UpdatedAt:   now,
+	})
+}
+
+func (p *tasteProfiler) RefreshAsync(userID primitive.ObjectID) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := p.Refresh(ctx, userID); err != nil {
+			log.Printf("[WARNING] failed to refresh taste profile of %s: %v", userID.Hex(), err)
+		}
+	}()
+}
+
+func squashAffinities(sums map[string]float64) map[string]float64 {
+	affinities := make(map[string]float64, len(sums))
+	for key, sum := range sums {
+		a := math.Tanh(sum / tasteScale)
+		if math.Abs(a) >= tasteMinAffinity {
+			affinities[key] = a
+		}
+	}
+	return affinities
+}
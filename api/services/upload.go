@@ -0,0 +1,83 @@
+// api/services/upload.go
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/apperr"
+	"github.com/seojoonrp/bapddang-server/models"
+	"github.com/seojoonrp/bapddang-server/storage"
+	"github.com/seojoonrp/bapddang-server/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// 원본은 긴 변 1600px, 썸네일은 320px까지 축소
+	imageMaxSide     = 1600
+	thumbnailMaxSide = 320
+)
+
+type UploadService interface {
+	UploadImage(ctx context.Context, purpose string, data []byte) (*models.UploadedImage, error)
+}
+
+type uploadService struct {
+	blobStore storage.BlobStore
+}
+
+func NewUploadService(bs storage.BlobStore) UploadService {
+	return &uploadService{
+		blobStore: bs,
+	}
+}
+
+func (s *uploadService) UploadImage(ctx context.Context, purpose string, data []byte) (*models.UploadedImage, error) {
+	if purpose != models.UploadPurposeReview && purpose != models.UploadPurposeFood {
+		return nil, apperr.BadRequest("invalid upload purpose", nil)
+	}
+
+	images, err := utils.ProcessImage(data, imageMaxSide, thumbnailMaxSide)
+	if err != nil {
+		if errors.Is(err, utils.ErrUnsupportedImageType) {
+			return nil, apperr.UnsupportedMediaType("only jpeg, png and gif images are allowed", err)
+		}
+		if errors.Is(err, utils.ErrImageTooLarge) {
+			return nil, apperr.PayloadTooLarge("image dimensions are too large", err)
+		}
+		return nil, apperr.InternalServerError("failed to process image", err)
+	}
+	main, thumb := images[0], images[1]
+
+	// 예: reviews/2026/10/<id>.jpg, reviews/2026/10/<id>_thumb.jpg
+	id := primitive.NewObjectID().Hex()
+	dir := fmt.Sprintf("%s/%s", purpose, time.Now().Format("2006/01"))
+	mainKey := fmt.Sprintf("%s/%s.%s", dir, id, main.Ext)
+	thumbKey := fmt.Sprintf("%s/%s_thumb.%s", dir, id, thumb.Ext)
+
+	mainURL, err := s.blobStore.Put(ctx, mainKey, main.ContentType, main.Data)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to store image", err)
+	}
+
+	thumbURL, err := s.blobStore.Put(ctx, thumbKey, thumb.ContentType, thumb.Data)
+	if err != nil {
+		// 썸네일 없이 원본만 남지 않도록 정리
+		if delErr := s.blobStore.Delete(ctx, mainKey); delErr != nil {
+			log.Printf("[WARNING] failed to clean up image %s: %v", mainKey, delErr)
+		}
+		return nil, apperr.InternalServerError("failed to store thumbnail", err)
+	}
+
+	return &models.UploadedImage{
+		URL:          mainURL,
+		ThumbnailURL: thumbURL,
+		ContentType:  main.ContentType,
+		Width:        main.Width,
+		Height:       main.Height,
+	}, nil
+}
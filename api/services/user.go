@@ -49,6 +49,8 @@ type UserService interface {
 	LoginWithKakao(ctx context.Context, req models.KakaoLoginRequest) (models.LoginResponse, error)
 	LoginWithApple(ctx context.Context, req models.AppleLoginRequest) (models.LoginResponse, error)
 	AgreeTerms(ctx context.Context, userID string) error
+	UpdateDietaryProfile(ctx context.Context, userID string, req models.UpdateDietaryProfileRequest) (*models.DietaryProfile, error)
+	UpdateLocale(ctx context.Context, userID string, req models.UpdateLocaleRequest) error
 
 	Withdraw(ctx context.Context, userID string) error
 
@@ -62,6 +64,11 @@ type userService struct {
 	likeRepo        repositories.LikeRepository
 	marshmallowRepo repositories.MarshmallowRepository
 	recHistoryRepo  repositories.RecHistoryRepository
+	experimentRepo  repositories.ExperimentRepository
+	recEventRepo    repositories.RecEventRepository
+	tasteRepo       repositories.TasteRepository
+	hideRepo        repositories.HideRepository
+	mealGroupRepo   repositories.MealGroupRepository
 }
 
 func NewUserService(
@@ -71,8 +78,13 @@ func NewUserService(
 	lr repositories.LikeRepository,
 	mr repositories.MarshmallowRepository,
 	rhr repositories.RecHistoryRepository,
+	er repositories.ExperimentRepository,
+	rer repositories.RecEventRepository,
+	tr repositories.TasteRepository,
+	hr repositories.HideRepository,
+	mgr repositories.MealGroupRepository,
 ) UserService {
-	return &userService{userRepo: ur, foodRepo: fr, reviewRepo: rr, likeRepo: lr, marshmallowRepo: mr, recHistoryRepo: rhr}
+	return &userService{userRepo: ur, foodRepo: fr, reviewRepo: rr, likeRepo: lr, marshmallowRepo: mr, recHistoryRepo: rhr, experimentRepo: er, recEventRepo: rer, tasteRepo: tr, hideRepo: hr, mealGroupRepo: mgr}
 }
 
 func (s *userService) CheckUsernameExists(ctx context.Context, username string) (bool, error) {
@@ -337,6 +349,68 @@ func (s *userService) AgreeTerms(ctx context.Context, userID string) error {
 	return nil
 }
 
+func validateDietaryTags(allergens []string, diets []string) error {
+	for _, allergen := range allergens {
+		if !models.IsValidAllergen(allergen) {
+			return apperr.BadRequest("invalid allergen: "+allergen, nil)
+		}
+	}
+	for _, diet := range diets {
+		if !models.IsValidDiet(diet) {
+			return apperr.BadRequest("invalid diet: "+diet, nil)
+		}
+	}
+	return nil
+}
+
+func (s *userService) UpdateDietaryProfile(ctx context.Context, userID string, req models.UpdateDietaryProfileRequest) (*models.DietaryProfile, error) {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	if err := validateDietaryTags(req.Allergens, req.Diets); err != nil {
+		return nil, err
+	}
+
+	profile := models.DietaryProfile{
+		Allergens: req.Allergens,
+		Diets:     req.Diets,
+	}
+	if profile.Allergens == nil {
+		profile.Allergens = []string{}
+	}
+	if profile.Diets == nil {
+		profile.Diets = []string{}
+	}
+
+	err = s.userRepo.UpdateDietaryProfile(ctx, uID, profile)
+	if err != nil {
+		return nil, apperr.InternalServerError("failed to update dietary profile", err)
+	}
+
+	return &profile, nil
+}
+
+// 빈 문자열이면 설정을 지우고 Accept-Language를 따름
+func (s *userService) UpdateLocale(ctx context.Context, userID string, req models.UpdateLocaleRequest) error {
+	uID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return apperr.InternalServerError("invalid user ID in token", err)
+	}
+
+	if req.Locale != "" && !models.IsSupportedLocale(req.Locale) {
+		return apperr.BadRequest("unsupported locale: "+req.Locale, nil)
+	}
+
+	err = s.userRepo.UpdateLocale(ctx, uID, req.Locale)
+	if err != nil {
+		return apperr.InternalServerError("failed to update locale", err)
+	}
+
+	return nil
+}
+
 func (s *userService) Withdraw(ctx context.Context, userID string) error {
 	uID, err := primitive.ObjectIDFromHex(userID)
 	if err != nil {
@@ -418,6 +492,11 @@ func (s *userService) Withdraw(ctx context.Context, userID string) error {
 	err = s.likeRepo.DeleteByUserID(ctx, uID)
 	err = s.marshmallowRepo.DeleteByUserID(ctx, uID)
 	err = s.recHistoryRepo.DeleteByUserID(ctx, uID)
+	err = s.experimentRepo.DeleteExposuresByUserID(ctx, uID)
+	err = s.recEventRepo.DeleteByUserID(ctx, uID)
+	err = s.tasteRepo.DeleteByUserID(ctx, uID)
+	err = s.hideRepo.DeleteByUserID(ctx, uID)
+	err = s.mealGroupRepo.RemoveMember(ctx, uID)
 	err = s.userRepo.Delete(ctx, uID)
 	if err != nil {
 		return apperr.InternalServerError("failed to delete user and related data", err)
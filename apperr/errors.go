@@ -73,6 +73,26 @@ func Conflict(msg string, raw error) *AppError {
 	}
 }
 
+// 413 Payload Too Large
+func PayloadTooLarge(msg string, raw error) *AppError {
+	return &AppError{
+		StatusCode: http.StatusRequestEntityTooLarge,
+		Code:       "PAYLOAD_TOO_LARGE",
+		Message:    msg,
+		Raw:        raw,
+	}
+}
+
+// 415 Unsupported Media Type
+func UnsupportedMediaType(msg string, raw error) *AppError {
+	return &AppError{
+		StatusCode: http.StatusUnsupportedMediaType,
+		Code:       "UNSUPPORTED_MEDIA_TYPE",
+		Message:    msg,
+		Raw:        raw,
+	}
+}
+
 // 422 Unprocessable Entity
 func UnprocessableEntity(msg string, raw error) *AppError {
 	return &AppError{
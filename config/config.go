@@ -7,6 +7,9 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -20,6 +23,7 @@ type Config struct {
 	DBName   string
 
 	JWTSecret string
+	AdminKey  string
 
 	GoogleWebClientID string
 	KakaoAdminKey     string
@@ -27,6 +31,76 @@ type Config struct {
 	AppleP8Key        string
 	AppleTeamID       string
 	AppleKeyID        string
+
+	// 업로드 파일 저장소 (local/s3)
+	StorageDriver  string
+	UploadDir      string
+	PublicBaseURL  string
+	MaxUploadBytes int64
+	S3Endpoint     string
+	S3Region       string
+	S3Bucket       string
+	S3AccessKey    string
+	S3SecretKey    string
+	S3PublicURL    string
+
+	RankingRefreshInterval  time.Duration
+	TrendingRefreshInterval time.Duration
+	PairingRefreshInterval  time.Duration
+	NeighborRefreshInterval time.Duration
+	MealTimeRefreshInterval time.Duration
+
+	Recommender RecommenderConfig
+
+	// 추천 후 이 시간 안에 남긴 리뷰만 추천 반응으로 인정
+	RecReviewWindow time.Duration
+}
+
+// 추천 전략과 점수 가중치
+type RecommenderConfig struct {
+	Strategy string
+
+	// 후보 풀 크기 = 요청 개수 * CandidateMultiplier
+	CandidateMultiplier int
+
+	// HistoryDays 안에 추천된 음식은 경과 시간 구간별 가중치를 받음
+	HistoryDays  int
+	DecayBuckets []DecayBucket
+	DecayFloor   float64
+
+	// 점수에 곱하는 랜덤 가중치 범위 (1±Jitter)
+	Jitter float64
+
+	// 직전 추천과 부모가 겹치지 않게 할 때 조회하는 기간
+	DiversityDays int
+
+	// 음식별 클릭률이 전체 평균보다 높을수록 가산. CTRWeight가 0이면 사용하지 않음
+	CTRWeight float64
+	CTRPrior  int
+	CTRDays   int
+
+	// 취향 선호도(-1 ~ 1)에 TasteWeight를 곱해 가감하고, Exploration 비율의 자리는 취향 없이 고름
+	TasteWeight float64
+	Exploration float64
+
+	// 협업 필터링 후보 수 = 요청 개수 * CFCandidates (0이면 사용하지 않음). 이웃 점수(0 ~ 1)에 CFWeight를 곱해 가산
+	CFCandidates int
+	CFWeight     float64
+
+	// AteDays 안에 리뷰로 먹었다고 남긴 음식은 경과 시간 구간별 가중치를 받고, 같은 부모 음식은 그 제곱근을 받음.
+	// AteDiversityWindow 안에 먹은 부모는 직전 추천처럼 다양성 제외 대상
+	AteDays            int
+	AteDecayBuckets    []DecayBucket
+	AteDecayFloor      float64
+	AteDiversityWindow time.Duration
+
+	// 식사 시간대 적합도(전체 평균 대비 배수)에 MealTimeWeight 제곱을 곱함. 0이면 사용하지 않음
+	MealTimeWeight float64
+}
+
+type DecayBucket struct {
+	Within time.Duration
+	Weight float64
 }
 
 var AppConfig *Config
@@ -46,6 +120,7 @@ func LoadConfig() {
 		DBName:   getEnv("DB_NAME", "bapddang-dev"),
 
 		JWTSecret: getEnv("JWT_KEY", "default_secret"),
+		AdminKey:  getEnv("ADMIN_KEY", ""),
 
 		GoogleWebClientID: getEnv("GOOGLE_WEB_CLIENT_ID", ""),
 		KakaoAdminKey:     getEnv("KAKAO_ADMIN_KEY", ""),
@@ -53,6 +128,55 @@ func LoadConfig() {
 		AppleP8Key:        getEnv("APPLE_P8_KEY", ""),
 		AppleTeamID:       getEnv("APPLE_TEAM_ID", ""),
 		AppleKeyID:        getEnv("APPLE_KEY_ID", ""),
+
+		StorageDriver:  getEnv("STORAGE_DRIVER", "local"),
+		UploadDir:      getEnv("UPLOAD_DIR", "./uploads"),
+		PublicBaseURL:  getEnv("PUBLIC_BASE_URL", "http://localhost:8080"),
+		MaxUploadBytes: int64(getEnvInt("MAX_UPLOAD_BYTES", 10<<20)),
+		S3Endpoint:     getEnv("S3_ENDPOINT", ""),
+		S3Region:       getEnv("S3_REGION", ""),
+		S3Bucket:       getEnv("S3_BUCKET", ""),
+		S3AccessKey:    getEnv("S3_ACCESS_KEY", ""),
+		S3SecretKey:    getEnv("S3_SECRET_KEY", ""),
+		S3PublicURL:    getEnv("S3_PUBLIC_URL", ""),
+
+		RankingRefreshInterval:  getEnvDuration("RANKING_REFRESH_INTERVAL", 30*time.Minute),
+		TrendingRefreshInterval: getEnvDuration("TRENDING_REFRESH_INTERVAL", 10*time.Minute),
+		PairingRefreshInterval:  getEnvDuration("PAIRING_REFRESH_INTERVAL", 6*time.Hour),
+		NeighborRefreshInterval: getEnvDuration("NEIGHBOR_REFRESH_INTERVAL", 6*time.Hour),
+		MealTimeRefreshInterval: getEnvDuration("MEAL_TIME_REFRESH_INTERVAL", 6*time.Hour),
+
+		Recommender: RecommenderConfig{
+			Strategy:            getEnv("REC_STRATEGY", "decay"),
+			CandidateMultiplier: getEnvInt("REC_CANDIDATE_MULTIPLIER", 7),
+			HistoryDays:         getEnvInt("REC_HISTORY_DAYS", 2),
+			DecayBuckets: getEnvDecayBuckets("REC_DECAY_BUCKETS", []DecayBucket{
+				{Within: time.Hour, Weight: 0.01},
+				{Within: 6 * time.Hour, Weight: 0.1},
+				{Within: 18 * time.Hour, Weight: 0.4},
+			}),
+			DecayFloor:    getEnvFloat("REC_DECAY_FLOOR", 0.8),
+			Jitter:        getEnvFloat("REC_JITTER", 0.2),
+			DiversityDays: getEnvInt("REC_DIVERSITY_DAYS", 7),
+			CTRWeight:     getEnvFloat("REC_CTR_WEIGHT", 0.5),
+			CTRPrior:      getEnvInt("REC_CTR_PRIOR", 20),
+			CTRDays:       getEnvInt("REC_CTR_DAYS", 14),
+			TasteWeight:   getEnvFloat("REC_TASTE_WEIGHT", 0.6),
+			Exploration:   getEnvFloat("REC_EXPLORATION", 0.2),
+			CFCandidates:  getEnvInt("REC_CF_CANDIDATES", 3),
+			CFWeight:      getEnvFloat("REC_CF_WEIGHT", 0.5),
+			AteDays:       getEnvInt("REC_ATE_DAYS", 2),
+			AteDecayBuckets: getEnvDecayBuckets("REC_ATE_DECAY_BUCKETS", []DecayBucket{
+				{Within: 6 * time.Hour, Weight: 0.05},
+				{Within: 18 * time.Hour, Weight: 0.2},
+				{Within: 36 * time.Hour, Weight: 0.5},
+			}),
+			AteDecayFloor:      getEnvFloat("REC_ATE_DECAY_FLOOR", 0.8),
+			AteDiversityWindow: getEnvDuration("REC_ATE_DIVERSITY_WINDOW", 12*time.Hour),
+			MealTimeWeight:     getEnvFloat("REC_MEAL_TIME_WEIGHT", 0.5),
+		},
+
+		RecReviewWindow: getEnvDuration("REC_REVIEW_WINDOW", 24*time.Hour),
 	}
 
 	if AppConfig.AppEnv == "production" && AppConfig.JWTSecret == "default_secret" {
@@ -66,3 +190,76 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+// "30m", "1h" 같은 형식이며, 잘못된 값이면 기본값을 사용
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Invalid duration for %s: %s. Using default %s.", key, value, fallback)
+		return fallback
+	}
+	return d
+}
+
+func getEnvInt(key string, fallback int) int {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Invalid integer for %s: %s. Using default %d.", key, value, fallback)
+		return fallback
+	}
+	return n
+}
+
+func getEnvFloat(key string, fallback float64) float64 {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Printf("Invalid float for %s: %s. Using default %g.", key, value, fallback)
+		return fallback
+	}
+	return f
+}
+
+// "1h:0.01,6h:0.1,18h:0.4" 형식이며, 구간은 짧은 순서여야 함
+func getEnvDecayBuckets(key string, fallback []DecayBucket) []DecayBucket {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+
+	var buckets []DecayBucket
+	for _, part := range strings.Split(value, ",") {
+		within, weight, found := strings.Cut(strings.TrimSpace(part), ":")
+		if !found {
+			log.Printf("Invalid decay buckets for %s: %s. Using default.", key, value)
+			return fallback
+		}
+		d, err := time.ParseDuration(within)
+		if err != nil {
+			log.Printf("Invalid decay buckets for %s: %s. Using default.", key, value)
+			return fallback
+		}
+		w, err := strconv.ParseFloat(weight, 64)
+		if err != nil {
+			log.Printf("Invalid decay buckets for %s: %s. Using default.", key, value)
+			return fallback
+		}
+		if len(buckets) > 0 && d <= buckets[len(buckets)-1].Within {
+			log.Printf("Decay buckets for %s must be in increasing order: %s. Using default.", key, value)
+			return fallback
+		}
+		buckets = append(buckets, DecayBucket{Within: d, Weight: w})
+	}
+	return buckets
+}
@@ -28,6 +28,7 @@ func ConnectDB() (*mongo.Client, error) {
 		return nil, err
 	}
 
+	RunMigrations(client)
 	InitIndexes(client)
 
 	log.Println("Successfully connected to MongoDB.")
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/seojoonrp/bapddang-server/config"
+	"github.com/seojoonrp/bapddang-server/models"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -23,6 +24,19 @@ func InitIndexes(client *mongo.Client) {
 	initLikeIndexes(db.Collection("likes"))
 	initRecHistoryIndexes(db.Collection("recommendation_histories"))
 	initMarshmallowIndexes(db.Collection("marshmallows"))
+	initCategoryIndexes(db.Collection("categories"))
+	initFoodRedirectIndexes(db.Collection("food_redirects"))
+	initRankingIndexes(db.Collection("ranking_snapshots"))
+	initPairingIndexes(db.Collection("food_pairings"))
+	initExperimentIndexes(db.Collection("experiments"))
+	initExperimentExposureIndexes(db.Collection("experiment_exposures"))
+	initRecEventIndexes(db.Collection("recommendation_events"))
+	initRecStatIndexes(db.Collection("recommendation_stats"))
+	initTasteIndexes(db.Collection("taste_profiles"))
+	initNeighborIndexes(db.Collection("food_neighbors"))
+	initHideIndexes(db.Collection("hides"))
+	initMealTimeIndexes(db.Collection("meal_time_suitability"))
+	initMealGroupIndexes(db.Collection("meal_groups"))
 }
 
 func initUserIndexes(coll *mongo.Collection) {
@@ -42,6 +56,38 @@ func initStandardFoodIndexes(coll *mongo.Collection) {
 		Keys:    bson.D{{Key: "name", Value: 1}},
 		Options: options.Index().SetUnique(true).SetName("idx_unique_food_name"),
 	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "categories", Value: 1}},
+		Options: options.Index().SetName("idx_food_categories"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "ingredients", Value: 1}},
+		Options: options.Index().SetName("idx_food_ingredients"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "parents", Value: 1}},
+		Options: options.Index().SetName("idx_food_parents"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "diet_tags", Value: 1}},
+		Options: options.Index().SetName("idx_food_diet_tags"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "attributes.$**", Value: 1}},
+		Options: options.Index().SetName("idx_food_attributes"),
+	})
+
+	for _, locale := range models.TranslatedLocales {
+		createIndex(coll, mongo.IndexModel{
+			Keys:    bson.D{{Key: "translations." + locale + ".name", Value: 1}},
+			Options: options.Index().SetName("idx_food_name_" + locale),
+		})
+	}
 }
 
 func initCustomFoodIndexes(coll *mongo.Collection) {
@@ -49,6 +95,24 @@ func initCustomFoodIndexes(coll *mongo.Collection) {
 		Keys:    bson.D{{Key: "name", Value: 1}},
 		Options: options.Index().SetName("idx_custom_food_name"),
 	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{{Key: "normalized_name", Value: 1}},
+		Options: options.Index().
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"normalized_name": bson.M{"$type": "string"}}).
+			SetName("idx_unique_custom_food_normalized_name"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "review_count", Value: -1}},
+		Options: options.Index().SetName("idx_custom_food_review_count"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "aliases", Value: 1}},
+		Options: options.Index().SetName("idx_custom_food_aliases"),
+	})
 }
 
 func initReviewIndexes(coll *mongo.Collection) {
@@ -59,6 +123,35 @@ func initReviewIndexes(coll *mongo.Collection) {
 		},
 		Options: options.Index().SetName("idx_user_day_review"),
 	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "week", Value: 1},
+		},
+		Options: options.Index().SetName("idx_user_week_review"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "foods.food_id", Value: 1},
+			{Key: "foods.type", Value: 1},
+		},
+		Options: options.Index().SetName("idx_review_food_items"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "created_at", Value: -1}},
+		Options: options.Index().SetName("idx_review_created_at"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+		Options: options.Index().SetName("idx_user_created_at_review"),
+	})
 }
 
 func initLikeIndexes(coll *mongo.Collection) {
@@ -74,6 +167,16 @@ func initLikeIndexes(coll *mongo.Collection) {
 		Keys:    bson.D{{Key: "user_id", Value: 1}},
 		Options: options.Index().SetName("idx_like_user_id"),
 	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "created_at", Value: 1}},
+		Options: options.Index().SetName("idx_like_created_at"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "food_id", Value: 1}},
+		Options: options.Index().SetName("idx_like_food_id"),
+	})
 }
 
 func initRecHistoryIndexes(coll *mongo.Collection) {
@@ -87,7 +190,7 @@ func initRecHistoryIndexes(coll *mongo.Collection) {
 
 	createIndex(coll, mongo.IndexModel{
 		Keys:    bson.D{{Key: "created_at", Value: 1}},
-		Options: options.Index().SetExpireAfterSeconds(60 * 60 * 24 * 3).SetName("idx_rec_history_ttl"),
+		Options: options.Index().SetExpireAfterSeconds(int32(models.RecHistoryRetention.Seconds())).SetName("idx_rec_history_ttl"),
 	})
 }
 
@@ -101,6 +204,204 @@ func initMarshmallowIndexes(coll *mongo.Collection) {
 	})
 }
 
+func initCategoryIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "key", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_category_key"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "parent_key", Value: 1},
+			{Key: "order", Value: 1},
+		},
+		Options: options.Index().SetName("idx_category_parent_order"),
+	})
+}
+
+func initFoodRedirectIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "from_id", Value: 1},
+			{Key: "type", Value: 1},
+		},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_redirect_from"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "to_id", Value: 1}},
+		Options: options.Index().SetName("idx_redirect_to"),
+	})
+}
+
+func initRankingIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "kind", Value: 1},
+			{Key: "period", Value: 1},
+		},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_ranking_kind_period"),
+	})
+}
+
+func initPairingIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "food_id", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_pairing_food_id"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "computed_at", Value: 1}},
+		Options: options.Index().SetName("idx_pairing_computed_at"),
+	})
+}
+
+func initExperimentIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_experiment_name"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{{Key: "status", Value: 1}},
+		Options: options.Index().
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": models.ExperimentStatusRunning}).
+			SetName("idx_unique_running_experiment"),
+	})
+}
+
+func initExperimentExposureIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "experiment", Value: 1},
+			{Key: "created_at", Value: 1},
+		},
+		Options: options.Index().SetName("idx_experiment_exposure_created_at"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}},
+		Options: options.Index().SetName("idx_experiment_exposure_user_id"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "food_ids", Value: 1}},
+		Options: options.Index().SetName("idx_experiment_exposure_food_ids"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "created_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(models.ExperimentExposureRetention.Seconds())).SetName("idx_experiment_exposure_ttl"),
+	})
+}
+
+func initRecEventIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "rec_id", Value: 1},
+			{Key: "food_id", Value: 1},
+			{Key: "type", Value: 1},
+		},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_rec_event"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}},
+		Options: options.Index().SetName("idx_rec_event_user_id"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "created_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(models.RecEventRetention.Seconds())).SetName("idx_rec_event_ttl"),
+	})
+}
+
+func initRecStatIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "scope", Value: 1},
+			{Key: "target_id", Value: 1},
+			{Key: "day", Value: 1},
+		},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_rec_stat"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "day", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(models.RecEventRetention.Seconds())).SetName("idx_rec_stat_ttl"),
+	})
+}
+
+func initTasteIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_taste_user_id"),
+	})
+}
+
+func initNeighborIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "food_id", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_neighbor_food_id"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "computed_at", Value: 1}},
+		Options: options.Index().SetName("idx_neighbor_computed_at"),
+	})
+}
+
+func initHideIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "scope", Value: 1},
+			{Key: "food_id", Value: 1},
+			{Key: "parent", Value: 1},
+		},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_hide_target"),
+	})
+
+	// 기간이 있는 숨김만 만료 후 삭제 (영구 숨김은 expires_at이 없음)
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0).SetName("idx_hide_expires_at_ttl"),
+	})
+}
+
+func initMealTimeIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "scope", Value: 1},
+			{Key: "target", Value: 1},
+		},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_meal_time_target"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "computed_at", Value: 1}},
+		Options: options.Index().SetName("idx_meal_time_computed_at"),
+	})
+}
+
+func initMealGroupIndexes(coll *mongo.Collection) {
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "code", Value: 1}},
+		Options: options.Index().SetUnique(true).SetName("idx_unique_meal_group_code"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "member_ids", Value: 1}},
+		Options: options.Index().SetName("idx_meal_group_member_ids"),
+	})
+
+	createIndex(coll, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0).SetName("idx_meal_group_expires_at_ttl"),
+	})
+}
+
 func createIndex(coll *mongo.Collection, model mongo.IndexModel) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -0,0 +1,160 @@
+// database/migrations.go
+
+package database
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/seojoonrp/bapddang-server/config"
+	"github.com/seojoonrp/bapddang-server/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func RunMigrations(client *mongo.Client) {
+	db := client.Database(config.AppConfig.DBName)
+
+	backfillCustomFoodNormalizedNames(db.Collection("custom_foods"))
+	normalizeCustomFoodAliases(db.Collection("custom_foods"))
+	backfillStandardFoodAllergensTagged(db.Collection("standard_foods"))
+}
+
+// allergens_tagged가 없는 기존 표준 음식 중 알레르기 유발 물질이 이미 입력된 음식만 확인된 것으로 표시.
+// 나머지는 관리자가 allergens를 채울 때까지 확인되지 않은 음식으로 남음
+func backfillStandardFoodAllergensTagged(coll *mongo.Collection) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	_, err := coll.UpdateMany(ctx,
+		bson.M{"allergens_tagged": bson.M{"$exists": false}, "allergens.0": bson.M{"$exists": true}},
+		bson.M{"$set": bson.M{"allergens_tagged": true}},
+	)
+	if err != nil {
+		log.Printf("Error while backfilling allergens_tagged on %s: %v", coll.Name(), err)
+		return
+	}
+
+	result, err := coll.UpdateMany(ctx,
+		bson.M{"allergens_tagged": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"allergens_tagged": false}},
+	)
+	if err != nil {
+		log.Printf("Error while backfilling allergens_tagged on %s: %v", coll.Name(), err)
+		return
+	}
+
+	if result.ModifiedCount > 0 {
+		log.Printf("Marked %d standard foods on %s as missing allergen information", result.ModifiedCount, coll.Name())
+	}
+}
+
+// normalized_name이 없는 기존 커스텀 음식에 값을 채움
+// 같은 이름으로 정규화되는 중복 음식은 리뷰가 가장 많은 하나만 채우고, 나머지는 관리자 병합 대상으로 남김
+func backfillCustomFoodNormalizedNames(coll *mongo.Collection) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	filter := bson.M{"normalized_name": bson.M{"$exists": false}}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "review_count", Value: -1}, {Key: "created_at", Value: 1}}).
+		SetProjection(bson.M{"_id": 1, "name": 1})
+
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		log.Printf("Error while backfilling normalized names on %s: %v", coll.Name(), err)
+		return
+	}
+	defer cursor.Close(ctx)
+
+	updated, skipped := 0, 0
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID   primitive.ObjectID `bson:"_id"`
+			Name string             `bson:"name"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			log.Printf("Error while decoding custom food: %v", err)
+			continue
+		}
+
+		normalized := utils.NormalizeFoodName(doc.Name)
+		taken, err := coll.CountDocuments(ctx, bson.M{"normalized_name": normalized})
+		if err != nil {
+			log.Printf("Error while checking normalized name %s: %v", normalized, err)
+			continue
+		}
+		if taken > 0 {
+			skipped++
+			continue
+		}
+
+		_, err = coll.UpdateOne(ctx, bson.M{"_id": doc.ID}, bson.M{"$set": bson.M{"normalized_name": normalized}})
+		if err != nil {
+			log.Printf("Error while setting normalized name on %s: %v", doc.ID.Hex(), err)
+			continue
+		}
+		updated++
+	}
+
+	if updated > 0 || skipped > 0 {
+		log.Printf("Backfilled normalized names on %s: %d updated, %d duplicates skipped", coll.Name(), updated, skipped)
+	}
+}
+
+// 병합 때 원래 이름 그대로 남긴 별칭을 정규화된 이름으로 바꿈
+func normalizeCustomFoodAliases(coll *mongo.Collection) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetProjection(bson.M{"_id": 1, "aliases": 1})
+	cursor, err := coll.Find(ctx, bson.M{"aliases.0": bson.M{"$exists": true}}, opts)
+	if err != nil {
+		log.Printf("Error while normalizing aliases on %s: %v", coll.Name(), err)
+		return
+	}
+	defer cursor.Close(ctx)
+
+	updated := 0
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID      primitive.ObjectID `bson:"_id"`
+			Aliases []string           `bson:"aliases"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			log.Printf("Error while decoding custom food: %v", err)
+			continue
+		}
+
+		changed := false
+		seen := make(map[string]bool, len(doc.Aliases))
+		aliases := make([]string, 0, len(doc.Aliases))
+		for _, alias := range doc.Aliases {
+			normalized := utils.NormalizeFoodName(alias)
+			changed = changed || normalized != alias
+			if normalized == "" || seen[normalized] {
+				changed = true
+				continue
+			}
+			seen[normalized] = true
+			aliases = append(aliases, normalized)
+		}
+		if !changed {
+			continue
+		}
+
+		_, err = coll.UpdateOne(ctx, bson.M{"_id": doc.ID}, bson.M{"$set": bson.M{"aliases": aliases}})
+		if err != nil {
+			log.Printf("Error while normalizing aliases on %s: %v", doc.ID.Hex(), err)
+			continue
+		}
+		updated++
+	}
+
+	if updated > 0 {
+		log.Printf("Normalized aliases on %s: %d updated", coll.Name(), updated)
+	}
+}
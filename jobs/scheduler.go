@@ -0,0 +1,59 @@
+// jobs/scheduler.go
+
+// 서버와 함께 돌아가는 주기 작업을 관리
+
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// 각 작업을 시작 즉시 한 번 실행한 뒤 Interval마다 반복하며, ctx가 취소되면 멈춤
+func Start(ctx context.Context, jobs ...Job) {
+	for _, job := range jobs {
+		if job.Interval <= 0 {
+			log.Printf("[WARNING] job %s disabled: interval must be positive", job.Name)
+			continue
+		}
+		go loop(ctx, job)
+	}
+}
+
+func loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		runOnce(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 다음 실행과 겹치지 않도록 Interval을 실행 시간 제한으로 사용
+func runOnce(ctx context.Context, job Job) {
+	runCtx, cancel := context.WithTimeout(ctx, job.Interval)
+	defer cancel()
+
+	start := time.Now()
+	if err := job.Run(runCtx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("[WARNING] job %s failed: %v", job.Name, err)
+		return
+	}
+	log.Printf("Job %s finished in %s.", job.Name, time.Since(start).Round(time.Millisecond))
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/seojoonrp/bapddang-server/api/services"
 	"github.com/seojoonrp/bapddang-server/config"
 	"github.com/seojoonrp/bapddang-server/database"
+	"github.com/seojoonrp/bapddang-server/jobs"
+	"github.com/seojoonrp/bapddang-server/storage"
 )
 
 // @title Bobttaeng API Server
@@ -57,25 +59,70 @@ func main() {
 	likeRepository := repositories.NewLikeRepository(db)
 	recHistoryRepository := repositories.NewRecHistoryRepository(db)
 	marshmallowRepository := repositories.NewMarshmallowRepository(db)
+	categoryRepository := repositories.NewCategoryRepository(db)
+	rankingRepository := repositories.NewRankingRepository(db)
+	pairingRepository := repositories.NewPairingRepository(db)
+	experimentRepository := repositories.NewExperimentRepository(db)
+	recEventRepository := repositories.NewRecEventRepository(db)
+	tasteRepository := repositories.NewTasteRepository(db)
+	neighborRepository := repositories.NewNeighborRepository(db)
+	hideRepository := repositories.NewHideRepository(db)
+	mealTimeRepository := repositories.NewMealTimeRepository(db)
+	mealGroupRepository := repositories.NewMealGroupRepository(db)
+
+	blobStore, err := storage.NewBlobStore()
+	if err != nil {
+		log.Fatal("Failed to initialize blob store: ", err)
+	}
+
+	recommenderFactory := services.NewRecommenderFactory(foodRepository, recHistoryRepository, recEventRepository, tasteRepository, neighborRepository, likeRepository, reviewRepository, mealTimeRepository)
+	recommender, err := recommenderFactory(config.AppConfig.Recommender)
+	if err != nil {
+		log.Fatal("Failed to initialize recommender: ", err)
+	}
 
-	userService := services.NewUserService(userRepository, foodRepository, reviewRepository, likeRepository, marshmallowRepository, recHistoryRepository)
-	foodService := services.NewFoodService(context.Background(), foodRepository, likeRepository, recHistoryRepository)
-	reviewService := services.NewReviewService(reviewRepository, foodRepository, userRepository, marshmallowRepository)
-	likeService := services.NewLikeService(likeRepository, foodRepository)
+	tasteProfiler := services.NewTasteProfiler(tasteRepository, likeRepository, reviewRepository, foodRepository)
+
+	userService := services.NewUserService(userRepository, foodRepository, reviewRepository, likeRepository, marshmallowRepository, recHistoryRepository, experimentRepository, recEventRepository, tasteRepository, hideRepository, mealGroupRepository)
+	foodService := services.NewFoodService(context.Background(), foodRepository, likeRepository, reviewRepository, recHistoryRepository, categoryRepository, userRepository, experimentRepository, recEventRepository, hideRepository, mealGroupRepository, recommender, recommenderFactory, config.AppConfig.Recommender)
+	reviewService := services.NewReviewService(reviewRepository, foodRepository, userRepository, marshmallowRepository, blobStore, tasteProfiler)
+	likeService := services.NewLikeService(likeRepository, foodRepository, tasteProfiler)
 	marshmallowService := services.NewMarshmallowService(marshmallowRepository)
+	categoryService := services.NewCategoryService(categoryRepository, foodRepository)
+	nutritionService := services.NewNutritionService(reviewRepository, foodRepository)
+	catalogService := services.NewCatalogService(foodRepository, reviewRepository, categoryRepository, likeRepository, recHistoryRepository, experimentRepository)
+	rankingService := services.NewRankingService(rankingRepository, foodRepository, reviewRepository, likeRepository, userRepository)
+	pairingService := services.NewPairingService(pairingRepository, reviewRepository, foodRepository, likeRepository, userRepository)
+	uploadService := services.NewUploadService(blobStore)
+	experimentService := services.NewExperimentService(experimentRepository)
+	recommendationService := services.NewRecommendationService(recEventRepository, recHistoryRepository, likeRepository, reviewRepository, config.AppConfig.RecReviewWindow)
+	neighborService := services.NewNeighborService(neighborRepository, likeRepository, reviewRepository)
+	hideService := services.NewHideService(hideRepository, foodRepository)
+	mealTimeService := services.NewMealTimeService(mealTimeRepository, reviewRepository, foodRepository)
+	mealGroupService := services.NewMealGroupService(mealGroupRepository, userRepository)
 
 	userHandler := handlers.NewUserHandler(userService, foodService)
 	foodHandler := handlers.NewFoodHandler(foodService)
 	reviewHandler := handlers.NewReviewHandler(reviewService, foodService)
 	likeHandler := handlers.NewLikeHandler(likeService)
 	marshmallowHandler := handlers.NewMarshmallowHandler(marshmallowService)
+	categoryHandler := handlers.NewCategoryHandler(categoryService)
+	catalogHandler := handlers.NewCatalogHandler(catalogService)
+	nutritionHandler := handlers.NewNutritionHandler(nutritionService)
+	rankingHandler := handlers.NewRankingHandler(rankingService)
+	pairingHandler := handlers.NewPairingHandler(pairingService)
+	uploadHandler := handlers.NewUploadHandler(uploadService)
+	experimentHandler := handlers.NewExperimentHandler(experimentService)
+	recommendationHandler := handlers.NewRecommendationHandler(recommendationService)
+	hideHandler := handlers.NewHideHandler(hideService)
+	mealGroupHandler := handlers.NewMealGroupHandler(mealGroupService)
 
 	router := gin.New()
 	router.Use(gin.Recovery())
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"https://api.bapddang.com", "https://bapddang.com"},
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "PATCH", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", "Accept"},
+		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", "Accept", "X-Admin-Key"},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
@@ -83,6 +130,11 @@ func main() {
 	router.Use(middleware.ErrorHandler())
 	router.SetTrustedProxies(nil)
 
+	// 로컬 저장소를 쓰는 경우 업로드한 파일을 직접 제공
+	if config.AppConfig.StorageDriver != "s3" {
+		router.Static(storage.LocalURLPrefix, config.AppConfig.UploadDir)
+	}
+
 	routes.SetupRoutes(
 		router,
 		db,
@@ -91,6 +143,26 @@ func main() {
 		reviewHandler,
 		likeHandler,
 		marshmallowHandler,
+		categoryHandler,
+		catalogHandler,
+		nutritionHandler,
+		rankingHandler,
+		pairingHandler,
+		uploadHandler,
+		experimentHandler,
+		recommendationHandler,
+		hideHandler,
+		mealGroupHandler,
+	)
+
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	jobs.Start(jobCtx,
+		jobs.Job{Name: "ranking-snapshots", Interval: config.AppConfig.RankingRefreshInterval, Run: rankingService.RefreshSnapshots},
+		jobs.Job{Name: "trending-snapshot", Interval: config.AppConfig.TrendingRefreshInterval, Run: rankingService.RefreshTrending},
+		jobs.Job{Name: "food-pairings", Interval: config.AppConfig.PairingRefreshInterval, Run: pairingService.RefreshPairings},
+		jobs.Job{Name: "food-neighbors", Interval: config.AppConfig.NeighborRefreshInterval, Run: neighborService.RefreshNeighbors},
+		jobs.Job{Name: "meal-time-suitability", Interval: config.AppConfig.MealTimeRefreshInterval, Run: mealTimeService.RefreshSuitability},
 	)
 
 	port := config.AppConfig.Port
@@ -115,6 +187,7 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutting down server...")
+	stopJobs()
 
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
@@ -0,0 +1,48 @@
+// models/attribute.go
+
+package models
+
+// StandardFood.Attributes의 facet key
+const (
+	AttrSpiciness   = "spiciness"
+	AttrSoup        = "soup"
+	AttrBase        = "base"
+	AttrTemperature = "temperature"
+	AttrPriceTier   = "price"
+)
+
+// facet key별로 허용되는 값
+var FoodAttributeValues = map[string][]string{
+	AttrSpiciness:   {"none", "mild", "hot"},
+	AttrSoup:        {"soup", "dry"},
+	AttrBase:        {"rice", "noodle", "bread", "other"},
+	AttrTemperature: {"hot", "cold"},
+	AttrPriceTier:   {"low", "mid", "high"},
+}
+
+// 응답과 쿼리 처리 순서를 고정하기 위한 facet key 목록
+var FoodAttributeKeys = []string{AttrSpiciness, AttrSoup, AttrBase, AttrTemperature, AttrPriceTier}
+
+func IsValidAttribute(key, value string) bool {
+	for _, v := range FoodAttributeValues[key] {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type BrowseFoodsQuery struct {
+	Speed      string
+	Attributes map[string][]string
+	Cursor     string
+	Count      int
+	Locale     string
+}
+
+// Facets는 facet key -> 값 -> 음식 수이며, 각 facet의 개수는 그 facet 자신의 조건을 뺀 나머지 조건 기준
+type BrowseFoodsResponse struct {
+	Foods      []FoodLikeResponse        `json:"foods"`
+	Facets     map[string]map[string]int `json:"facets"`
+	NextCursor string                    `json:"nextCursor,omitempty"`
+}
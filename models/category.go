@@ -0,0 +1,46 @@
+// models/category.go
+
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Key는 StandardFood.Categories에 저장되는 값과 동일하며, ParentKey가 비어 있으면 최상위 카테고리
+type Category struct {
+	ID           primitive.ObjectID       `bson:"_id,omitempty" json:"id"`
+	Key          string                   `bson:"key" json:"key"`
+	Name         string                   `bson:"name" json:"name"`
+	Icon         string                   `bson:"icon" json:"icon"`
+	ParentKey    string                   `bson:"parent_key,omitempty" json:"parentKey,omitempty"`
+	Order        int                      `bson:"order" json:"order"`
+	Translations map[string]LocalizedText `bson:"translations,omitempty" json:"translations,omitempty"`
+	CreatedAt    time.Time                `bson:"created_at" json:"createdAt"`
+}
+
+func (c Category) LocalizedName(locale string) string {
+	if t, ok := c.Translations[locale]; ok && locale != DefaultLocale && t.Name != "" {
+		return t.Name
+	}
+	return c.Name
+}
+
+type CreateCategoryRequest struct {
+	Key          string                   `json:"key" binding:"required"`
+	Name         string                   `json:"name" binding:"required"`
+	Icon         string                   `json:"icon"`
+	ParentKey    string                   `json:"parentKey"`
+	Order        int                      `json:"order"`
+	Translations map[string]LocalizedText `json:"translations"`
+}
+
+type CategoryNode struct {
+	Key       string         `json:"key"`
+	Name      string         `json:"name"`
+	Icon      string         `json:"icon"`
+	Order     int            `json:"order"`
+	FoodCount int            `json:"foodCount"`
+	Children  []CategoryNode `json:"children"`
+}
@@ -7,8 +7,17 @@ const (
 	FoodTypeCustom   = "custom"
 )
 
+// Portion은 1인분 대비 먹은 양이며, 0이면 1인분으로 취급
 type ReviewFoodItem struct {
-	FoodID   string `bson:"food_id" json:"foodID"`
-	FoodName string `bson:"food_name" json:"foodName"`
-	Type     string `bson:"type" json:"type"`
+	FoodID   string  `bson:"food_id" json:"foodID"`
+	FoodName string  `bson:"food_name" json:"foodName"`
+	Type     string  `bson:"type" json:"type"`
+	Portion  float64 `bson:"portion,omitempty" json:"portion,omitempty"`
+}
+
+func (i ReviewFoodItem) EffectivePortion() float64 {
+	if i.Portion <= 0 {
+		return 1
+	}
+	return i.Portion
 }
@@ -0,0 +1,121 @@
+// models/dietary.go
+
+package models
+
+// 식품 알레르기 유발 물질 (식품 표시 기준의 알레르기 표시 대상)
+const (
+	AllergenEgg       = "egg"
+	AllergenMilk      = "milk"
+	AllergenBuckwheat = "buckwheat"
+	AllergenPeanut    = "peanut"
+	AllergenSoybean   = "soybean"
+	AllergenWheat     = "wheat"
+	AllergenMackerel  = "mackerel"
+	AllergenCrab      = "crab"
+	AllergenShrimp    = "shrimp"
+	AllergenPork      = "pork"
+	AllergenPeach     = "peach"
+	AllergenTomato    = "tomato"
+	AllergenSulfite   = "sulfite"
+	AllergenWalnut    = "walnut"
+	AllergenChicken   = "chicken"
+	AllergenBeef      = "beef"
+	AllergenSquid     = "squid"
+	AllergenShellfish = "shellfish"
+	AllergenPineNut   = "pine_nut"
+)
+
+// 음식의 DietTags에는 해당 식단을 지키는 사람도 먹을 수 있는 경우에만 태그를 붙임
+const (
+	DietVegetarian  = "vegetarian"
+	DietVegan       = "vegan"
+	DietPescatarian = "pescatarian"
+	DietHalal       = "halal"
+)
+
+var validAllergens = map[string]bool{
+	AllergenEgg: true, AllergenMilk: true, AllergenBuckwheat: true, AllergenPeanut: true,
+	AllergenSoybean: true, AllergenWheat: true, AllergenMackerel: true, AllergenCrab: true,
+	AllergenShrimp: true, AllergenPork: true, AllergenPeach: true, AllergenTomato: true,
+	AllergenSulfite: true, AllergenWalnut: true, AllergenChicken: true, AllergenBeef: true,
+	AllergenSquid: true, AllergenShellfish: true, AllergenPineNut: true,
+}
+
+var validDiets = map[string]bool{
+	DietVegetarian:  true,
+	DietVegan:       true,
+	DietPescatarian: true,
+	DietHalal:       true,
+}
+
+func IsValidAllergen(allergen string) bool {
+	return validAllergens[allergen]
+}
+
+func IsValidDiet(diet string) bool {
+	return validDiets[diet]
+}
+
+type DietaryProfile struct {
+	Allergens []string `bson:"allergens" json:"allergens"`
+	Diets     []string `bson:"diets" json:"diets"`
+}
+
+func (p DietaryProfile) IsEmpty() bool {
+	return len(p.Allergens) == 0 && len(p.Diets) == 0
+}
+
+// 여러 사람이 함께 먹을 때는 모두의 알레르기와 식단을 지켜야 함
+func MergeDietaryProfiles(profiles []DietaryProfile) DietaryProfile {
+	var merged DietaryProfile
+	seenAllergens := make(map[string]bool)
+	seenDiets := make(map[string]bool)
+	for _, p := range profiles {
+		for _, allergen := range p.Allergens {
+			if !seenAllergens[allergen] {
+				seenAllergens[allergen] = true
+				merged.Allergens = append(merged.Allergens, allergen)
+			}
+		}
+		for _, diet := range p.Diets {
+			if !seenDiets[diet] {
+				seenDiets[diet] = true
+				merged.Diets = append(merged.Diets, diet)
+			}
+		}
+	}
+	return merged
+}
+
+type UpdateDietaryProfileRequest struct {
+	Allergens []string `json:"allergens"`
+	Diets     []string `json:"diets"`
+}
+
+// 알레르기 유발 물질이 하나도 겹치지 않고, 요구하는 식단 태그를 모두 가진 음식인지 확인.
+// 알레르기가 있으면 알레르기 정보를 확인하지 않은 음식도 허용하지 않음
+func (p DietaryProfile) Allows(food StandardFood) bool {
+	if len(p.Allergens) > 0 && !food.AllergensTagged {
+		return false
+	}
+	for _, excluded := range p.Allergens {
+		for _, allergen := range food.Allergens {
+			if allergen == excluded {
+				return false
+			}
+		}
+	}
+	for _, diet := range p.Diets {
+		found := false
+		for _, tag := range food.DietTags {
+			if tag == diet {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
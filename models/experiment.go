@@ -0,0 +1,107 @@
+// models/experiment.go
+
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	ExperimentStatusDraft   = "draft"
+	ExperimentStatusRunning = "running"
+	ExperimentStatusStopped = "stopped"
+)
+
+// 메인 피드 추천 A/B 실험. 동시에 하나만 running일 수 있음
+type Experiment struct {
+	ID          primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	Name        string              `bson:"name" json:"name"`
+	Description string              `bson:"description" json:"description"`
+	Variants    []ExperimentVariant `bson:"variants" json:"variants"`
+	Status      string              `bson:"status" json:"status"`
+	StartedAt   *time.Time          `bson:"started_at,omitempty" json:"startedAt,omitempty"`
+	StoppedAt   *time.Time          `bson:"stopped_at,omitempty" json:"stoppedAt,omitempty"`
+	CreatedAt   time.Time           `bson:"created_at" json:"createdAt"`
+}
+
+// Weight는 트래픽 비율(%)이며 한 실험의 합은 100
+type ExperimentVariant struct {
+	Name   string            `bson:"name" json:"name" binding:"required"`
+	Weight int               `bson:"weight" json:"weight" binding:"required"`
+	Params RecommenderParams `bson:"params" json:"params"`
+}
+
+// 기본 추천 설정을 덮어쓰는 값. 비어 있는 항목은 기본값을 그대로 사용
+type RecommenderParams struct {
+	Strategy            string        `bson:"strategy,omitempty" json:"strategy,omitempty"`
+	CandidateMultiplier *int          `bson:"candidate_multiplier,omitempty" json:"candidateMultiplier,omitempty"`
+	HistoryDays         *int          `bson:"history_days,omitempty" json:"historyDays,omitempty"`
+	DecayBuckets        []DecayBucket `bson:"decay_buckets,omitempty" json:"decayBuckets,omitempty"`
+	DecayFloor          *float64      `bson:"decay_floor,omitempty" json:"decayFloor,omitempty"`
+	Jitter              *float64      `bson:"jitter,omitempty" json:"jitter,omitempty"`
+	DiversityDays       *int          `bson:"diversity_days,omitempty" json:"diversityDays,omitempty"`
+	CTRWeight           *float64      `bson:"ctr_weight,omitempty" json:"ctrWeight,omitempty"`
+	TasteWeight         *float64      `bson:"taste_weight,omitempty" json:"tasteWeight,omitempty"`
+	Exploration         *float64      `bson:"exploration,omitempty" json:"exploration,omitempty"`
+	CFCandidates        *int          `bson:"cf_candidates,omitempty" json:"cfCandidates,omitempty"`
+	CFWeight            *float64      `bson:"cf_weight,omitempty" json:"cfWeight,omitempty"`
+	AteDays             *int          `bson:"ate_days,omitempty" json:"ateDays,omitempty"`
+	AteDecayBuckets     []DecayBucket `bson:"ate_decay_buckets,omitempty" json:"ateDecayBuckets,omitempty"`
+	AteDecayFloor       *float64      `bson:"ate_decay_floor,omitempty" json:"ateDecayFloor,omitempty"`
+	MealTimeWeight      *float64      `bson:"meal_time_weight,omitempty" json:"mealTimeWeight,omitempty"`
+}
+
+type DecayBucket struct {
+	WithinHours float64 `bson:"within_hours" json:"withinHours"`
+	Weight      float64 `bson:"weight" json:"weight"`
+}
+
+type CreateExperimentRequest struct {
+	Name        string              `json:"name" binding:"required"`
+	Description string              `json:"description"`
+	Variants    []ExperimentVariant `json:"variants" binding:"required,dive"`
+}
+
+type UpdateExperimentStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// 추천 기록에 남기는 실험 배정 결과
+type ExperimentAssignment struct {
+	Experiment string
+	Variant    string
+}
+
+// 추천 기록은 며칠 뒤 지워지므로, 실험 결과 집계용 노출은 따로 이 기간 동안 보관함
+const ExperimentExposureRetention = 90 * 24 * time.Hour
+
+// 실험 중인 사용자에게 보여준 추천. ID는 같은 추천의 추천 기록 ID와 같음
+type ExperimentExposure struct {
+	ID         primitive.ObjectID   `bson:"_id"`
+	Experiment string               `bson:"experiment"`
+	Variant    string               `bson:"variant"`
+	UserID     primitive.ObjectID   `bson:"user_id"`
+	FoodIDs    []primitive.ObjectID `bson:"food_ids"`
+	CreatedAt  time.Time            `bson:"created_at"`
+}
+
+// 추천 후 Window 안에 좋아요/리뷰로 이어진 비율
+type VariantConversion struct {
+	Variant     string  `bson:"_id" json:"variant"`
+	Users       int     `bson:"users" json:"users"`
+	Feeds       int     `bson:"feeds" json:"feeds"`
+	Impressions int     `bson:"impressions" json:"impressions"`
+	Liked       int     `bson:"liked" json:"liked"`
+	Reviewed    int     `bson:"reviewed" json:"reviewed"`
+	LikeRate    float64 `bson:"-" json:"likeRate"`
+	ReviewRate  float64 `bson:"-" json:"reviewRate"`
+}
+
+type ExperimentReport struct {
+	Experiment  Experiment          `json:"experiment"`
+	Since       time.Time           `json:"since"`
+	WindowHours int                 `json:"windowHours"`
+	Variants    []VariantConversion `json:"variants"`
+}
@@ -15,35 +15,157 @@ const (
 )
 
 type StandardFood struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Name        string             `bson:"name" json:"name" binding:"required"`
-	ImageURL    string             `bson:"image_url" json:"imageURL" binding:"required"`
-	Speed       string             `bson:"speed" json:"speed" binding:"required"`
-	Parents     []string           `bson:"parents" json:"parents"`
-	Categories  []string           `bson:"categories" json:"categories"`
-	LikeCount   int                `bson:"like_count" json:"likeCount"`
-	ReviewCount int                `bson:"review_count" json:"reviewCount"`
-	TotalRating int                `bson:"total_rating" json:"totalRating"`
+	ID           primitive.ObjectID       `bson:"_id,omitempty" json:"id"`
+	Name         string                   `bson:"name" json:"name" binding:"required"`
+	ImageURL     string                   `bson:"image_url" json:"imageURL" binding:"required"`
+	Speed        string                   `bson:"speed" json:"speed" binding:"required"`
+	Parents      []string                 `bson:"parents" json:"parents"`
+	Categories   []string                 `bson:"categories" json:"categories"`
+	LikeCount    int                      `bson:"like_count" json:"likeCount"`
+	ReviewCount  int                      `bson:"review_count" json:"reviewCount"`
+	TotalRating  int                      `bson:"total_rating" json:"totalRating"`
+	Nutrition    *Nutrition               `bson:"nutrition,omitempty" json:"nutrition,omitempty"`
+	Allergens    []string                 `bson:"allergens,omitempty" json:"allergens,omitempty"`
+	DietTags     []string                 `bson:"diet_tags,omitempty" json:"dietTags,omitempty"`
+	Attributes   map[string]string        `bson:"attributes,omitempty" json:"attributes,omitempty"`
+	Description  string                   `bson:"description,omitempty" json:"description,omitempty"`
+	Translations map[string]LocalizedText `bson:"translations,omitempty" json:"translations,omitempty"`
+	Ingredients  []string                 `bson:"ingredients,omitempty" json:"ingredients,omitempty"`
+	// 알레르기 유발 물질을 확인했는지 여부. 확인하지 않은 음식은 알레르기가 있는 사용자에게 추천하지 않음
+	AllergensTagged bool `bson:"allergens_tagged" json:"allergensTagged"`
 }
 
+// 해당 언어의 번역이 있으면 Name과 Description을 번역으로 바꿈
+func (f *StandardFood) Localize(locale string) {
+	t, ok := f.Translations[locale]
+	if !ok || locale == DefaultLocale {
+		return
+	}
+	if t.Name != "" {
+		f.Name = t.Name
+	}
+	if t.Description != "" {
+		f.Description = t.Description
+	}
+}
+
+// Allergens를 빈 배열로 보내면 알레르기 유발 물질이 없는 것으로 확인된 것이고, 생략하면 확인되지 않은 것으로 봄
 type CreateStandardFoodRequest struct {
-	Name       string   `json:"name" binding:"required"`
-	ImageURL   string   `json:"imageURL" binding:"required"`
-	Speed      string   `json:"speed" binding:"required"`
-	Parents    []string `json:"parents" binding:"required"`
-	Categories []string `json:"categories" binding:"required"`
+	Name         string                   `json:"name" binding:"required"`
+	ImageURL     string                   `json:"imageURL" binding:"required"`
+	Speed        string                   `json:"speed" binding:"required"`
+	Parents      []string                 `json:"parents" binding:"required"`
+	Categories   []string                 `json:"categories" binding:"required"`
+	Nutrition    *Nutrition               `json:"nutrition"`
+	Allergens    []string                 `json:"allergens"`
+	DietTags     []string                 `json:"dietTags"`
+	Attributes   map[string]string        `json:"attributes"`
+	Description  string                   `json:"description"`
+	Translations map[string]LocalizedText `json:"translations"`
+	Ingredients  []string                 `json:"ingredients"`
+}
+
+// 보낸 항목만 바꿈. Allergens를 보내면(빈 배열 포함) 알레르기 확인이 끝난 것으로 표시됨
+type UpdateStandardFoodRequest struct {
+	ImageURL     *string                   `json:"imageURL"`
+	Allergens    *[]string                 `json:"allergens"`
+	DietTags     *[]string                 `json:"dietTags"`
+	Attributes   *map[string]string        `json:"attributes"`
+	Description  *string                   `json:"description"`
+	Translations *map[string]LocalizedText `json:"translations"`
+	Ingredients  *[]string                 `json:"ingredients"`
 }
 
 type CustomFood struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Name        string             `bson:"name" json:"name" binding:"required"`
-	ReviewCount int                `bson:"review_count" json:"reviewCount"`
-	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name           string             `bson:"name" json:"name" binding:"required"`
+	NormalizedName string             `bson:"normalized_name,omitempty" json:"-"`
+	ReviewCount    int                `bson:"review_count" json:"reviewCount"`
+	CreatedAt      time.Time          `bson:"created_at" json:"createdAt"`
+	Aliases        []string           `bson:"aliases,omitempty" json:"aliases,omitempty"`
+	// 승격 중이거나 승격된 표준 음식의 ID. 승격을 다시 시도해도 같은 표준 음식을 사용함
+	PromotedTo primitive.ObjectID `bson:"promoted_to,omitempty" json:"-"`
+}
+
+// Name이 비어 있으면 커스텀 음식의 이름을 그대로 사용. Allergens는 CreateStandardFoodRequest와 같은 규칙을 따름
+type PromoteCustomFoodRequest struct {
+	Name         string                   `json:"name"`
+	ImageURL     string                   `json:"imageURL" binding:"required"`
+	Speed        string                   `json:"speed" binding:"required"`
+	Parents      []string                 `json:"parents" binding:"required"`
+	Categories   []string                 `json:"categories" binding:"required"`
+	Nutrition    *Nutrition               `json:"nutrition"`
+	Allergens    []string                 `json:"allergens"`
+	DietTags     []string                 `json:"dietTags"`
+	Attributes   map[string]string        `json:"attributes"`
+	Description  string                   `json:"description"`
+	Translations map[string]LocalizedText `json:"translations"`
+	Ingredients  []string                 `json:"ingredients"`
+}
+
+// 병합되어 사라진 음식 ID를 살아남은 음식 ID로 연결
+type FoodRedirect struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	FromID    primitive.ObjectID `bson:"from_id" json:"fromID"`
+	ToID      primitive.ObjectID `bson:"to_id" json:"toID"`
+	Type      string             `bson:"type" json:"type"`
+	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
+}
+
+// SourceID의 음식이 TargetID의 음식으로 합쳐지고 SourceID는 삭제됨
+type MergeFoodsRequest struct {
+	Type     string `json:"type" binding:"required"`
+	SourceID string `json:"sourceID" binding:"required"`
+	TargetID string `json:"targetID" binding:"required"`
+}
+
+type MergeFoodsResponse struct {
+	Type           string `json:"type"`
+	SourceID       string `json:"sourceID"`
+	TargetID       string `json:"targetID"`
+	ReviewsUpdated int64  `json:"reviewsUpdated"`
+	LikesMoved     int64  `json:"likesMoved"`
+	ReviewCount    int    `json:"reviewCount"`
+	TotalRating    int    `json:"totalRating"`
+	LikeCount      int    `json:"likeCount"`
+}
+
+type FoodCoOccurrence struct {
+	FoodID primitive.ObjectID `bson:"_id"`
+	Count  int                `bson:"count"`
 }
 
+// 요리에 꼭 필요한 재료 중 가진 재료의 비율(Coverage)과 부족한 재료
+type FridgeFood struct {
+	FoodLikeResponse
+	Coverage           float64  `json:"coverage"`
+	MissingIngredients []string `json:"missingIngredients"`
+}
+
+type FridgeRequest struct {
+	Ingredients []string `json:"ingredients" binding:"required"`
+	Count       int      `json:"count"`
+}
+
+// Reasons는 메인 피드에서만 채워짐
 type FoodLikeResponse struct {
 	Food    StandardFood `json:"food"`
 	IsLiked bool         `json:"isLiked"`
+	Reasons []RecReason  `json:"reasons,omitempty"`
+}
+
+// LimitedByProfile은 식단 프로필로 제외된 음식 때문에 요청한 개수를 채우지 못한 경우 true
+type FeedMeta struct {
+	Requested        int    `json:"requested"`
+	Returned         int    `json:"returned"`
+	LimitedByProfile bool   `json:"limitedByProfile"`
+	RecommendationID string `json:"recommendationID,omitempty"`
+	MealTime         string `json:"mealTime,omitempty"`
+}
+
+type FoodFeedResult struct {
+	Foods []FoodLikeResponse
+	Meta  FeedMeta
 }
 
 type ResolveFoodItemsRequest struct {
@@ -0,0 +1,44 @@
+// models/food_detail.go
+
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RatingHistogram은 1~5점 각각의 리뷰 수 (key: "1" ~ "5")
+type FoodDetailResponse struct {
+	Food              StandardFood    `json:"food"`
+	IsLiked           bool            `json:"isLiked"`
+	LikeCount         int             `json:"likeCount"`
+	ReviewCount       int             `json:"reviewCount"`
+	AverageRating     float64         `json:"averageRating"`
+	RatingHistogram   map[int]int     `json:"ratingHistogram"`
+	MealTimeBreakdown map[string]int  `json:"mealTimeBreakdown"`
+	Reviews           []ReviewSnippet `json:"reviews"`
+	NextCursor        string          `json:"nextCursor,omitempty"`
+}
+
+// 작성자 정보 없이 공개 가능한 필드만 담은 리뷰 요약
+type ReviewSnippet struct {
+	ID        primitive.ObjectID `bson:"_id" json:"id"`
+	Comment   string             `bson:"comment" json:"comment"`
+	Rating    int                `bson:"rating" json:"rating"`
+	MealTime  string             `bson:"meal_time" json:"mealTime"`
+	ImageURL  string             `bson:"image_url" json:"imageURL"`
+	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
+}
+
+type ReviewSnippetPage struct {
+	Reviews    []ReviewSnippet `json:"reviews"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+}
+
+type FoodReviewStats struct {
+	ReviewCount       int
+	TotalRating       int
+	RatingHistogram   map[int]int
+	MealTimeBreakdown map[string]int
+}
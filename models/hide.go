@@ -0,0 +1,51 @@
+// models/hide.go
+
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 숨김 대상: 음식 하나(food) 또는 같은 부모 음식 전체(parent)
+const (
+	HideScopeFood   = "food"
+	HideScopeParent = "parent"
+)
+
+// 숨김 기간: 일주일(week) 또는 직접 해제할 때까지(forever)
+const (
+	HideDurationWeek    = "week"
+	HideDurationForever = "forever"
+)
+
+const HideWeek = 7 * 24 * time.Hour
+
+// 메인 피드와 카테고리 추천에서 제외할 음식/부모.
+// 영구 숨김은 ExpiresAt이 없으며, 기간이 있는 숨김은 만료되면 TTL 인덱스로 지워짐
+type Hide struct {
+	ID        primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	UserID    primitive.ObjectID  `bson:"user_id" json:"-"`
+	Scope     string              `bson:"scope" json:"scope"`
+	FoodID    *primitive.ObjectID `bson:"food_id,omitempty" json:"foodID,omitempty"`
+	Parent    string              `bson:"parent,omitempty" json:"parent,omitempty"`
+	ExpiresAt *time.Time          `bson:"expires_at,omitempty" json:"expiresAt,omitempty"`
+	CreatedAt time.Time           `bson:"created_at" json:"createdAt"`
+}
+
+func (h Hide) IsActive(now time.Time) bool {
+	return h.ExpiresAt == nil || h.ExpiresAt.After(now)
+}
+
+// scope가 parent면 음식의 부모를 모두 숨김
+type CreateHideRequest struct {
+	Scope    string `json:"scope" binding:"required"`
+	Duration string `json:"duration" binding:"required"`
+}
+
+// food 숨김에만 음식 정보를 담음
+type HideResponse struct {
+	Hide
+	Food *StandardFood `json:"food,omitempty"`
+}
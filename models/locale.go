@@ -0,0 +1,37 @@
+// models/locale.go
+
+package models
+
+// 기본 언어는 한국어이며, 한국어 이름은 Name 필드에 그대로 저장
+const (
+	LocaleKorean   = "ko"
+	LocaleEnglish  = "en"
+	LocaleJapanese = "ja"
+	LocaleChinese  = "zh"
+
+	DefaultLocale = LocaleKorean
+)
+
+// Translations에 저장될 수 있는 언어 (기본 언어 제외)
+var TranslatedLocales = []string{LocaleEnglish, LocaleJapanese, LocaleChinese}
+
+func IsSupportedLocale(locale string) bool {
+	if locale == DefaultLocale {
+		return true
+	}
+	for _, l := range TranslatedLocales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
+
+type LocalizedText struct {
+	Name        string `bson:"name" json:"name"`
+	Description string `bson:"description,omitempty" json:"description,omitempty"`
+}
+
+type UpdateLocaleRequest struct {
+	Locale string `json:"locale"`
+}
@@ -0,0 +1,47 @@
+// models/meal_group.go
+
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// 같이 먹을 사람을 모으는 공유 코드는 하루 동안 유효
+	MealGroupTTL = 24 * time.Hour
+	// 만든 사람을 포함한 최대 인원
+	MealGroupMaxMembers = 10
+)
+
+// 공유 코드로 모인 함께 식사할 사용자들. 만료되면 TTL 인덱스로 지워짐
+type MealGroup struct {
+	ID        primitive.ObjectID   `bson:"_id,omitempty" json:"-"`
+	Code      string               `bson:"code" json:"code"`
+	OwnerID   primitive.ObjectID   `bson:"owner_id" json:"ownerID"`
+	MemberIDs []primitive.ObjectID `bson:"member_ids" json:"-"`
+	ExpiresAt time.Time            `bson:"expires_at" json:"expiresAt"`
+	CreatedAt time.Time            `bson:"created_at" json:"createdAt"`
+}
+
+type MealGroupMember struct {
+	ID       primitive.ObjectID `json:"id"`
+	Username string             `json:"username"`
+}
+
+type MealGroupResponse struct {
+	MealGroup
+	Members []MealGroupMember `json:"members"`
+}
+
+// Code나 UserIDs 중 하나로 인원을 정하며, 요청한 사용자는 항상 포함됨.
+// UserIDs는 요청자와 함께 만료되지 않은 같은 모임에 들어와 있어야 함
+type GroupFeedQuery struct {
+	Code     string
+	UserIDs  []string
+	Speed    string
+	Count    int
+	MealTime string
+	Timezone string
+}
@@ -0,0 +1,68 @@
+// models/meal_time.go
+
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// 추천에 쓰는 식사 시간대
+const (
+	MealTimeBreakfast = "breakfast"
+	MealTimeLunch     = "lunch"
+	MealTimeDinner    = "dinner"
+	MealTimeLateNight = "late_night"
+)
+
+var MealTimes = []string{MealTimeBreakfast, MealTimeLunch, MealTimeDinner, MealTimeLateNight}
+
+// 리뷰의 MealTime은 자유 입력이라 한국어/영어 표기를 시간대로 맞춤. 간식 등 시간대가 아닌 값은 ok가 false
+func NormalizeMealTime(value string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case MealTimeBreakfast, "morning", "아침", "아침식사", "조식", "브런치", "brunch":
+		return MealTimeBreakfast, true
+	case MealTimeLunch, "점심", "점심식사", "중식":
+		return MealTimeLunch, true
+	case MealTimeDinner, "evening", "저녁", "저녁식사", "석식":
+		return MealTimeDinner, true
+	case MealTimeLateNight, "latenight", "late night", "night", "야식", "밤":
+		return MealTimeLateNight, true
+	}
+	return "", false
+}
+
+// 현지 시각 기준 5~10시 아침, 10~15시 점심, 15~21시 저녁, 그 외 야식
+func MealTimeAt(t time.Time) string {
+	switch h := t.Hour(); {
+	case h >= 5 && h < 10:
+		return MealTimeBreakfast
+	case h >= 10 && h < 15:
+		return MealTimeLunch
+	case h >= 15 && h < 21:
+		return MealTimeDinner
+	default:
+		return MealTimeLateNight
+	}
+}
+
+// 음식(food) 또는 부모(parent)별로 시간대마다 리뷰가 전체 평균보다 얼마나 많은지의 배수
+const (
+	MealTimeScopeFood   = "food"
+	MealTimeScopeParent = "parent"
+)
+
+type MealTimeSuitability struct {
+	Scope       string             `bson:"scope" json:"scope"`
+	Target      string             `bson:"target" json:"target"`
+	Weights     map[string]float64 `bson:"weights" json:"weights"`
+	ReviewCount int                `bson:"review_count" json:"reviewCount"`
+	ComputedAt  time.Time          `bson:"computed_at" json:"computedAt"`
+}
+
+// 표준 음식별 리뷰의 식사 시간대 표기별 개수
+type FoodMealTimeCount struct {
+	FoodID   string `bson:"food_id"`
+	MealTime string `bson:"meal_time"`
+	Count    int    `bson:"count"`
+}
@@ -0,0 +1,31 @@
+// models/neighbor.go
+
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 같은 사용자들이 함께 좋아하거나 높게 평가한 음식 (item-item 협업 필터링)
+type FoodNeighbors struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	FoodID     primitive.ObjectID `bson:"food_id" json:"foodID"`
+	Neighbors  []NeighborEntry    `bson:"neighbors" json:"neighbors"`
+	ComputedAt time.Time          `bson:"computed_at" json:"computedAt"`
+}
+
+// Similarity는 사용자 선호 벡터의 코사인 유사도에 함께 평가한 사용자 수로 보정을 곱한 값
+type NeighborEntry struct {
+	FoodID     primitive.ObjectID `bson:"food_id" json:"foodID"`
+	Similarity float64            `bson:"similarity" json:"similarity"`
+	CoUsers    int                `bson:"co_users" json:"coUsers"`
+}
+
+// 사용자별 표준 음식 최고 평점
+type UserFoodRating struct {
+	UserID primitive.ObjectID `bson:"user_id"`
+	FoodID primitive.ObjectID `bson:"food_id"`
+	Rating int                `bson:"rating"`
+}
@@ -0,0 +1,47 @@
+// models/nutrition.go
+
+package models
+
+// 1인분 기준 영양 정보 (나트륨은 mg, 나머지는 kcal/g)
+type Nutrition struct {
+	Kcal    float64 `bson:"kcal" json:"kcal"`
+	Carbs   float64 `bson:"carbs" json:"carbs"`
+	Protein float64 `bson:"protein" json:"protein"`
+	Fat     float64 `bson:"fat" json:"fat"`
+	Sodium  float64 `bson:"sodium" json:"sodium"`
+}
+
+func (n Nutrition) Add(other Nutrition, portion float64) Nutrition {
+	return Nutrition{
+		Kcal:    n.Kcal + other.Kcal*portion,
+		Carbs:   n.Carbs + other.Carbs*portion,
+		Protein: n.Protein + other.Protein*portion,
+		Fat:     n.Fat + other.Fat*portion,
+		Sodium:  n.Sodium + other.Sodium*portion,
+	}
+}
+
+func (n Nutrition) Scale(factor float64) Nutrition {
+	return Nutrition{}.Add(n, factor)
+}
+
+type UpdateNutritionRequest struct {
+	Nutrition *Nutrition `json:"nutrition"`
+}
+
+// UntrackedFoods에는 영양 정보가 없어 합계에서 빠진 음식 이름이 들어감
+type DailyNutritionSummary struct {
+	Day            int                  `json:"day"`
+	Total          Nutrition            `json:"total"`
+	ByMealTime     map[string]Nutrition `json:"byMealTime"`
+	MealCount      int                  `json:"mealCount"`
+	UntrackedFoods []string             `json:"untrackedFoods"`
+}
+
+// DailyAverage는 기록이 있는 날만을 기준으로 한 평균
+type WeeklyNutritionSummary struct {
+	Week         int                     `json:"week"`
+	Total        Nutrition               `json:"total"`
+	DailyAverage Nutrition               `json:"dailyAverage"`
+	Days         []DailyNutritionSummary `json:"days"`
+}
@@ -0,0 +1,32 @@
+// models/pairing.go
+
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 여러 음식이 담긴 리뷰를 장바구니로 보고 계산한 연관 규칙 (FoodID -> Pairs[].FoodID)
+type FoodPairing struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	FoodID     primitive.ObjectID `bson:"food_id" json:"foodID"`
+	Pairs      []PairingEntry     `bson:"pairs" json:"pairs"`
+	ComputedAt time.Time          `bson:"computed_at" json:"computedAt"`
+}
+
+// Confidence는 FoodID가 담긴 리뷰 중 이 음식도 담긴 비율, Lift는 우연히 함께 나올 확률 대비 배수
+type PairingEntry struct {
+	FoodID     primitive.ObjectID `bson:"food_id" json:"foodID"`
+	PairCount  int                `bson:"pair_count" json:"pairCount"`
+	Confidence float64            `bson:"confidence" json:"confidence"`
+	Lift       float64            `bson:"lift" json:"lift"`
+}
+
+type PairedFood struct {
+	FoodLikeResponse
+	PairCount  int     `json:"pairCount"`
+	Confidence float64 `json:"confidence"`
+	Lift       float64 `json:"lift"`
+}
@@ -0,0 +1,82 @@
+// models/ranking.go
+
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	RankingKindRating   = "rating"
+	RankingKindLikes    = "likes"
+	RankingKindTrending = "trending"
+)
+
+const (
+	RankingPeriodWeek  = "week"
+	RankingPeriodMonth = "month"
+	RankingPeriodAll   = "all"
+	// 급상승 순위는 최근 활동 구간 하나만 계산
+	RankingPeriodRecent = "recent"
+)
+
+var RankingPeriods = []string{RankingPeriodWeek, RankingPeriodMonth, RankingPeriodAll}
+
+func IsValidRankingPeriod(period string) bool {
+	for _, p := range RankingPeriods {
+		if p == period {
+			return true
+		}
+	}
+	return false
+}
+
+// 주기적으로 계산해 (kind, period)마다 하나씩 덮어쓰는 순위 스냅샷
+type RankingSnapshot struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Kind        string             `bson:"kind" json:"kind"`
+	Period      string             `bson:"period" json:"period"`
+	Entries     []RankingEntry     `bson:"entries" json:"entries"`
+	PeriodStart time.Time          `bson:"period_start" json:"periodStart"`
+	ComputedAt  time.Time          `bson:"computed_at" json:"computedAt"`
+}
+
+type RankingEntry struct {
+	FoodID        primitive.ObjectID `bson:"food_id" json:"foodID"`
+	Score         float64            `bson:"score" json:"score"`
+	ReviewCount   int                `bson:"review_count" json:"reviewCount"`
+	AverageRating float64            `bson:"average_rating" json:"averageRating"`
+	LikeCount     int                `bson:"like_count" json:"likeCount"`
+}
+
+// 기간 내 음식별 리뷰 수와 평점 합계
+type FoodRatingStat struct {
+	FoodID      primitive.ObjectID `bson:"_id"`
+	ReviewCount int                `bson:"review_count"`
+	TotalRating int                `bson:"total_rating"`
+}
+
+// 최근 구간 안의 활동 수와 시간 감쇠를 적용한 활동 점수
+type FoodActivityStat struct {
+	FoodID       primitive.ObjectID `bson:"_id"`
+	Count        int                `bson:"count"`
+	DecayedScore float64            `bson:"decayed_score"`
+}
+
+type RankedFood struct {
+	FoodLikeResponse
+	Rank          int     `json:"rank"`
+	Score         float64 `json:"score"`
+	ReviewCount   int     `json:"reviewCount"`
+	AverageRating float64 `json:"averageRating"`
+	LikeCount     int     `json:"likeCount"`
+}
+
+type RankingResponse struct {
+	Kind       string       `json:"kind"`
+	Period     string       `json:"period"`
+	Foods      []RankedFood `json:"foods"`
+	ComputedAt time.Time    `json:"computedAt"`
+}
@@ -0,0 +1,67 @@
+// models/rec_event.go
+
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 추천받은 음식에 대한 사용자 반응
+const (
+	RecEventClick  = "click"
+	RecEventLike   = "like"
+	RecEventReview = "review"
+)
+
+const (
+	RecStatScopeFood   = "food"
+	RecStatScopeUser   = "user"
+	RecStatScopeGlobal = "global"
+)
+
+// 반응 이벤트와 일별 집계는 TTL 인덱스로 이 기간이 지나면 삭제됨
+const RecEventRetention = 30 * 24 * time.Hour
+
+type RecEvent struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	RecommendationID primitive.ObjectID `bson:"rec_id" json:"recommendationID"`
+	UserID           primitive.ObjectID `bson:"user_id" json:"userID"`
+	FoodID           primitive.ObjectID `bson:"food_id" json:"foodID"`
+	Type             string             `bson:"type" json:"type"`
+	CreatedAt        time.Time          `bson:"created_at" json:"createdAt"`
+}
+
+type CreateRecEventRequest struct {
+	RecommendationID string `json:"recommendationID" binding:"required"`
+	FoodID           string `json:"foodID" binding:"required"`
+	Type             string `json:"type" binding:"required"`
+}
+
+// 음식별/사용자별/전체 일별 노출·반응 카운터. 전체(global)는 TargetID가 비어 있음
+type RecStat struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Scope       string             `bson:"scope"`
+	TargetID    primitive.ObjectID `bson:"target_id"`
+	Day         time.Time          `bson:"day"`
+	Impressions int                `bson:"impressions"`
+	Clicks      int                `bson:"clicks"`
+	Likes       int                `bson:"likes"`
+	Reviews     int                `bson:"reviews"`
+}
+
+type CTRStat struct {
+	TargetID    primitive.ObjectID `bson:"_id" json:"targetID"`
+	Impressions int                `bson:"impressions" json:"impressions"`
+	Clicks      int                `bson:"clicks" json:"clicks"`
+	Likes       int                `bson:"likes" json:"likes"`
+	Reviews     int                `bson:"reviews" json:"reviews"`
+	CTR         float64            `bson:"-" json:"ctr"`
+}
+
+func (s *CTRStat) ComputeCTR() {
+	if s.Impressions > 0 {
+		s.CTR = float64(s.Clicks) / float64(s.Impressions)
+	}
+}
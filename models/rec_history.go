@@ -8,10 +8,15 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// 추천 기록은 TTL 인덱스로 이 기간이 지나면 삭제됨
+const RecHistoryRetention = 3 * 24 * time.Hour
+
 type RecHistory struct {
-	ID        primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
-	UserID    primitive.ObjectID   `bson:"user_id" json:"userID"`
-	FoodIDs   []primitive.ObjectID `bson:"food_ids" json:"foodIDs"`
-	Parents   []string             `bson:"parents" json:"parents"`
-	CreatedAt time.Time            `bson:"created_at" json:"createdAt"`
+	ID         primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
+	UserID     primitive.ObjectID   `bson:"user_id" json:"userID"`
+	FoodIDs    []primitive.ObjectID `bson:"food_ids" json:"foodIDs"`
+	Parents    []string             `bson:"parents" json:"parents"`
+	Experiment string               `bson:"experiment,omitempty" json:"experiment,omitempty"`
+	Variant    string               `bson:"variant,omitempty" json:"variant,omitempty"`
+	CreatedAt  time.Time            `bson:"created_at" json:"createdAt"`
 }
@@ -0,0 +1,28 @@
+// models/rec_reason.go
+
+package models
+
+// 추천 이유 코드. 문구는 앱에서 코드별로 만듦
+const (
+	// 최근 Days일 동안 추천되지 않음 (다른 이유가 없을 때만)
+	RecReasonNotShownRecently = "not_shown_recently"
+	// 비슷한 음식을 좋아하거나 높게 평가함
+	RecReasonSimilarToLiked = "similar_to_liked"
+	// 최근 Days일 동안 추천됐을 때 다른 음식보다 많이 눌림
+	RecReasonPopular = "popular"
+	// 좋아하는 카테고리(Detail)의 음식
+	RecReasonMatchesTaste = "matches_taste"
+	// 아직 반응한 적 없는 카테고리(Detail)의 음식
+	RecReasonNewCategory = "new_category"
+	// 지금 시간대(Detail)에 많이 먹는 음식
+	RecReasonFitsMealTime = "fits_meal_time"
+	// 함께 먹는 사람 중 Count명이 좋아하는 종류
+	RecReasonGroupMatch = "group_match"
+)
+
+type RecReason struct {
+	Code   string `json:"code"`
+	Days   int    `json:"days,omitempty"`
+	Detail string `json:"detail,omitempty"`
+	Count  int    `json:"count,omitempty"`
+}
@@ -0,0 +1,59 @@
+// models/taste.go
+
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// 좋아요와 리뷰 평점으로 계산한 카테고리/부모별 선호도 (-1 ~ 1)
+type TasteProfile struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"-"`
+	UserID      primitive.ObjectID `bson:"user_id" json:"userID"`
+	Categories  map[string]float64 `bson:"categories" json:"categories"`
+	Parents     map[string]float64 `bson:"parents" json:"parents"`
+	SignalCount int                `bson:"signal_count" json:"signalCount"`
+	UpdatedAt   time.Time          `bson:"updated_at" json:"updatedAt"`
+}
+
+// 음식의 카테고리와 부모 중 선호도가 있는 것들의 평균. 아는 게 없으면 ok가 false
+func (p *TasteProfile) Affinity(food StandardFood) (float64, bool) {
+	if p == nil {
+		return 0, false
+	}
+
+	sum, n := 0.0, 0
+	for _, category := range food.Categories {
+		if a, ok := p.Categories[category]; ok {
+			sum += a
+			n++
+		}
+	}
+	for _, parent := range food.Parents {
+		if a, ok := p.Parents[parent]; ok {
+			sum += a
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return sum / float64(n), true
+}
+
+// 음식의 카테고리 중 선호도가 가장 높은 것. 좋아하는 카테고리가 없으면 빈 문자열
+func (p *TasteProfile) FavoriteCategory(food StandardFood) string {
+	if p == nil {
+		return ""
+	}
+
+	best, bestAffinity := "", 0.0
+	for _, category := range food.Categories {
+		if a := p.Categories[category]; a > bestAffinity {
+			best, bestAffinity = category, a
+		}
+	}
+	return best
+}
@@ -0,0 +1,17 @@
+// models/upload.go
+
+package models
+
+// 업로드 용도에 따라 저장 경로가 나뉨
+const (
+	UploadPurposeReview = "reviews"
+	UploadPurposeFood   = "foods"
+)
+
+type UploadedImage struct {
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnailURL"`
+	ContentType  string `json:"contentType"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+}
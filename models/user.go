@@ -28,6 +28,8 @@ type User struct {
 	AgreedAt          time.Time          `bson:"agreed_at,omitempty" json:"agreedAt,omitempty"`
 	CreatedAt         time.Time          `bson:"created_at" json:"createdAt"`
 	AppleRefreshToken string             `bson:"apple_refresh_token,omitempty" json:"-"`
+	DietaryProfile    DietaryProfile     `bson:"dietary_profile" json:"dietaryProfile"`
+	Locale            string             `bson:"locale,omitempty" json:"locale,omitempty"`
 }
 
 type SignUpRequest struct {
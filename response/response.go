@@ -5,6 +5,7 @@ package response
 type Response struct {
 	Success bool         `json:"success"`
 	Data    interface{}  `json:"data,omitempty"`
+	Meta    interface{}  `json:"meta,omitempty"`
 	Error   *ErrorDetail `json:"error,omitempty"`
 }
 
@@ -0,0 +1,41 @@
+// storage/blob.go
+
+// 업로드한 파일을 저장하는 저장소 추상화
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seojoonrp/bapddang-server/config"
+)
+
+type BlobStore interface {
+	// key 위치에 data를 저장하고 외부에서 접근 가능한 URL을 반환
+	Put(ctx context.Context, key string, contentType string, data []byte) (string, error)
+	Delete(ctx context.Context, key string) error
+	// Put이 반환하는 URL들의 공통 접두사 (끝의 / 제외)
+	BaseURL() string
+}
+
+// STORAGE_DRIVER 설정(local/s3)에 맞는 저장소를 생성
+func NewBlobStore() (BlobStore, error) {
+	cfg := config.AppConfig
+
+	switch cfg.StorageDriver {
+	case "", "local":
+		return NewLocalBlobStore(cfg.UploadDir, cfg.PublicBaseURL+LocalURLPrefix)
+	case "s3":
+		return NewS3BlobStore(S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			PublicURL: cfg.S3PublicURL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", cfg.StorageDriver)
+	}
+}
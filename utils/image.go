@@ -0,0 +1,269 @@
+// utils/image.go
+
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+const (
+	// 압축 폭탄 방지를 위해 디코딩 전에 확인하는 최대 픽셀 수 (RGBA 기준 약 64MB)
+	maxImagePixels = 16_000_000
+	jpegQuality    = 85
+)
+
+var (
+	ErrUnsupportedImageType = errors.New("unsupported image type")
+	ErrImageTooLarge        = errors.New("image dimensions too large")
+)
+
+type ProcessedImage struct {
+	Data        []byte
+	ContentType string
+	Ext         string
+	Width       int
+	Height      int
+}
+
+// 파일 내용으로 실제 형식을 판별 (확장자나 클라이언트가 보낸 Content-Type은 신뢰하지 않음)
+func SniffImageType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+		return contentType, nil
+	default:
+		return "", ErrUnsupportedImageType
+	}
+}
+
+// 이미지를 디코딩해 EXIF 방향대로 회전하고, 긴 변 기준 maxSides 크기들로 다시 인코딩.
+// 다시 인코딩하므로 EXIF 등 메타데이터는 모두 제거됨
+func ProcessImage(data []byte, maxSides ...int) ([]ProcessedImage, error) {
+	contentType, err := SniffImageType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedImageType
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxImagePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	var img image.Image
+	switch contentType {
+	case "image/jpeg":
+		img, err = jpeg.Decode(bytes.NewReader(data))
+	case "image/png":
+		img, err = png.Decode(bytes.NewReader(data))
+	case "image/gif":
+		// 움직이는 GIF는 첫 프레임만 사용
+		img, err = gif.Decode(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, ErrUnsupportedImageType
+	}
+
+	// 디코딩 직후 가장 큰 출력 크기로 한 번만 줄여, 회전과 썸네일 단계가 원본 크기 버퍼를 만들지 않도록 함
+	largest := 0
+	for _, maxSide := range maxSides {
+		if maxSide <= 0 {
+			largest = 0
+			break
+		}
+		largest = max(largest, maxSide)
+	}
+	working := resizeToFit(toRGBA(img), largest)
+	if contentType == "image/jpeg" {
+		working = applyOrientation(working, jpegOrientation(data))
+	}
+
+	results := make([]ProcessedImage, 0, len(maxSides))
+	for _, maxSide := range maxSides {
+		resized := resizeToFit(working, maxSide)
+
+		var buf bytes.Buffer
+		out := ProcessedImage{
+			Width:  resized.Bounds().Dx(),
+			Height: resized.Bounds().Dy(),
+		}
+		// 투명도가 있을 수 있는 PNG/GIF는 PNG로, 나머지는 JPEG로 저장
+		if contentType == "image/jpeg" {
+			err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: jpegQuality})
+			out.ContentType, out.Ext = "image/jpeg", "jpg"
+		} else {
+			err = png.Encode(&buf, resized)
+			out.ContentType, out.Ext = "image/png", "png"
+		}
+		if err != nil {
+			return nil, err
+		}
+		out.Data = buf.Bytes()
+		results = append(results, out)
+	}
+
+	return results, nil
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	return rgba
+}
+
+// 긴 변이 maxSide를 넘으면 영역 평균으로 축소 (확대는 하지 않음)
+func resizeToFit(src *image.RGBA, maxSide int) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	if maxSide <= 0 || (sw <= maxSide && sh <= maxSide) {
+		return src
+	}
+
+	dw, dh := maxSide, maxSide
+	if sw >= sh {
+		dh = max(1, sh*maxSide/sw)
+	} else {
+		dw = max(1, sw*maxSide/sh)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		sy0, sy1 := y*sh/dh, max((y+1)*sh/dh, y*sh/dh+1)
+		for x := 0; x < dw; x++ {
+			sx0, sx1 := x*sw/dw, max((x+1)*sw/dw, x*sw/dw+1)
+
+			var r, g, b, a, n uint32
+			for sy := sy0; sy < sy1; sy++ {
+				i := src.PixOffset(sx0, sy)
+				for sx := sx0; sx < sx1; sx++ {
+					r += uint32(src.Pix[i])
+					g += uint32(src.Pix[i+1])
+					b += uint32(src.Pix[i+2])
+					a += uint32(src.Pix[i+3])
+					n++
+					i += 4
+				}
+			}
+
+			j := dst.PixOffset(x, y)
+			dst.Pix[j] = uint8(r / n)
+			dst.Pix[j+1] = uint8(g / n)
+			dst.Pix[j+2] = uint8(b / n)
+			dst.Pix[j+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// EXIF Orientation(1~8)에 맞춰 이미지를 바로 세움
+func applyOrientation(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // 좌우 반전
+				dx, dy = w-1-x, y
+			case 3: // 180도 회전
+				dx, dy = w-1-x, h-1-y
+			case 4: // 상하 반전
+				dx, dy = x, h-1-y
+			case 5: // 좌상-우하 대각선 기준 반전
+				dx, dy = y, x
+			case 6: // 시계 방향 90도 회전
+				dx, dy = h-1-y, x
+			case 7: // 우상-좌하 대각선 기준 반전
+				dx, dy = h-1-y, w-1-x
+			case 8: // 반시계 방향 90도 회전
+				dx, dy = y, w-1-x
+			}
+			si, di := src.PixOffset(x, y), dst.PixOffset(dx, dy)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+	return dst
+}
+
+// JPEG의 APP1(EXIF) 세그먼트에서 Orientation 태그를 읽고, 없거나 읽을 수 없으면 1
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return 1
+		}
+		marker := data[pos+1]
+		// SOS 이후는 이미지 데이터이므로 중단
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		size := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		if size < 2 || pos+2+size > len(data) {
+			return 1
+		}
+		segment := data[pos+4 : pos+2+size]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return exifOrientation(segment[6:])
+		}
+		pos += 2 + size
+	}
+	return 1
+}
+
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd+2 > len(tiff) {
+		return 1
+	}
+	entries := int(order.Uint16(tiff[ifd : ifd+2]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:entry+2]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8 : entry+10]))
+		}
+	}
+	return 1
+}
@@ -0,0 +1,213 @@
+// utils/image_test.go
+
+package utils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func testImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, testImage(w, h), nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage(w, h)); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodeGIF(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := gif.Encode(&buf, testImage(w, h), nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// SOI 바로 뒤에 Orientation 태그 하나만 담은 EXIF(APP1) 세그먼트를 끼워 넣음
+func withExifOrientation(data []byte, order binary.ByteOrder, tag uint16, orientation uint16) []byte {
+	tiff := make([]byte, 8+2+12+4)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 42)
+	order.PutUint32(tiff[4:], 8)
+	order.PutUint16(tiff[8:], 1)
+	order.PutUint16(tiff[10:], tag)
+	order.PutUint16(tiff[12:], 3)
+	order.PutUint32(tiff[14:], 1)
+	order.PutUint16(tiff[18:], orientation)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+// PNG 헤더(IHDR)의 크기만 바꿔, 실제로 큰 이미지를 만들지 않고 크기 제한을 확인
+func withPNGSize(data []byte, w, h uint32) []byte {
+	out := append([]byte{}, data...)
+	binary.BigEndian.PutUint32(out[16:], w)
+	binary.BigEndian.PutUint32(out[20:], h)
+	binary.BigEndian.PutUint32(out[29:], crc32.ChecksumIEEE(out[12:29]))
+	return out
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	plain := encodeJPEG(t, 4, 4)
+
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{name: "no exif", data: plain, want: 1},
+		{name: "little endian", data: withExifOrientation(plain, binary.LittleEndian, 0x0112, 6), want: 6},
+		{name: "big endian", data: withExifOrientation(plain, binary.BigEndian, 0x0112, 3), want: 3},
+		{name: "other tag only", data: withExifOrientation(plain, binary.LittleEndian, 0x010F, 6), want: 1},
+		{name: "not a jpeg", data: encodePNG(t, 4, 4), want: 1},
+		{name: "truncated segment", data: withExifOrientation(plain, binary.BigEndian, 0x0112, 8)[:12], want: 1},
+		{name: "empty", data: nil, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jpegOrientation(tt.data); got != tt.want {
+				t.Errorf("jpegOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProcessImage(t *testing.T) {
+	type size struct{ w, h int }
+
+	tests := []struct {
+		name            string
+		data            []byte
+		maxSides        []int
+		wantErr         error
+		wantContentType string
+		wantSizes       []size
+	}{
+		{
+			name:            "jpeg is scaled down per size",
+			data:            encodeJPEG(t, 400, 200),
+			maxSides:        []int{100, 40},
+			wantContentType: "image/jpeg",
+			wantSizes:       []size{{100, 50}, {40, 20}},
+		},
+		{
+			name:            "exif rotation is applied",
+			data:            withExifOrientation(encodeJPEG(t, 400, 200), binary.LittleEndian, 0x0112, 6),
+			maxSides:        []int{100},
+			wantContentType: "image/jpeg",
+			wantSizes:       []size{{50, 100}},
+		},
+		{
+			name:            "small png is not enlarged",
+			data:            encodePNG(t, 30, 20),
+			maxSides:        []int{100, 10},
+			wantContentType: "image/png",
+			wantSizes:       []size{{30, 20}, {10, 6}},
+		},
+		{
+			name:            "gif is stored as png",
+			data:            encodeGIF(t, 60, 90),
+			maxSides:        []int{30},
+			wantContentType: "image/png",
+			wantSizes:       []size{{20, 30}},
+		},
+		{
+			name:            "non-positive size keeps the original",
+			data:            encodePNG(t, 300, 150),
+			maxSides:        []int{0, 50},
+			wantContentType: "image/png",
+			wantSizes:       []size{{300, 150}, {50, 25}},
+		},
+		{
+			name:     "unsupported type",
+			data:     []byte("hello, this is not an image"),
+			maxSides: []int{100},
+			wantErr:  ErrUnsupportedImageType,
+		},
+		{
+			name:     "too many pixels",
+			data:     withPNGSize(encodePNG(t, 1, 1), 5000, 4000),
+			maxSides: []int{100},
+			wantErr:  ErrImageTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProcessImage(tt.data, tt.maxSides...)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.wantSizes) {
+				t.Fatalf("len = %d, want %d", len(got), len(tt.wantSizes))
+			}
+
+			for i, out := range got {
+				if out.ContentType != tt.wantContentType {
+					t.Errorf("image %d content type = %s, want %s", i, out.ContentType, tt.wantContentType)
+				}
+				if out.Width != tt.wantSizes[i].w || out.Height != tt.wantSizes[i].h {
+					t.Errorf("image %d size = %dx%d, want %dx%d", i, out.Width, out.Height, tt.wantSizes[i].w, tt.wantSizes[i].h)
+				}
+
+				cfg, _, err := image.DecodeConfig(bytes.NewReader(out.Data))
+				if err != nil {
+					t.Fatalf("image %d is not decodable: %v", i, err)
+				}
+				if cfg.Width != out.Width || cfg.Height != out.Height {
+					t.Errorf("image %d encoded size = %dx%d, want %dx%d", i, cfg.Width, cfg.Height, out.Width, out.Height)
+				}
+				if bytes.Contains(out.Data, []byte("Exif\x00\x00")) {
+					t.Errorf("image %d still contains exif metadata", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// utils/locale.go
+
+package utils
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/seojoonrp/bapddang-server/models"
+)
+
+// Accept-Language 헤더에서 q 값이 가장 높은 지원 언어를 고르고, 없으면 기본 언어를 반환
+// 예: "en-US,en;q=0.9,ko;q=0.8" -> "en"
+func ParseAcceptLanguage(header string) string {
+	type candidate struct {
+		locale string
+		q      float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if tag == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					q = v
+				}
+			}
+		}
+
+		base := strings.SplitN(tag, "-", 2)[0]
+		if models.IsSupportedLocale(base) && q > 0 {
+			candidates = append(candidates, candidate{locale: base, q: q})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return models.DefaultLocale
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	return candidates[0].locale
+}
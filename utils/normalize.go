@@ -0,0 +1,21 @@
+// utils/normalize.go
+
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 공백을 모두 제거하고 소문자로 바꿔, 띄어쓰기나 대소문자만 다른 음식 이름을 같은 이름으로 취급
+func NormalizeFoodName(name string) string {
+	var b strings.Builder
+	b.Grow(len(name))
+	for _, r := range name {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,31 @@
+// utils/normalize_test.go
+
+package utils
+
+import "testing"
+
+func TestNormalizeFoodName(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "already normalized", in: "김치찌개", want: "김치찌개"},
+		{name: "inner spaces", in: "김치 찌개", want: "김치찌개"},
+		{name: "surrounding spaces", in: "  김치찌개 ", want: "김치찌개"},
+		{name: "tabs and newlines", in: "김치\t찌개\n", want: "김치찌개"},
+		{name: "full-width space", in: "김치　찌개", want: "김치찌개"},
+		{name: "upper case", in: "Pad Thai", want: "padthai"},
+		{name: "mixed script", in: "BBQ 치킨", want: "bbq치킨"},
+		{name: "blank", in: "   ", want: ""},
+		{name: "empty", in: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeFoodName(tt.in); got != tt.want {
+				t.Errorf("NormalizeFoodName(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}